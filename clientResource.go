@@ -0,0 +1,221 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting clients
+type ClientResourceResponse struct {
+	Clients []ClientResource `json:"clients"`
+	Pagination
+}
+
+func (cr ClientResourceResponse) Items() []ClientResource {
+	return cr.Clients
+}
+
+// A client, as in one of the company's customers. Named ClientResource to
+// avoid colliding with the HTTP Client used to make requests.
+type ClientResource struct {
+	// Unique ID for the client.
+	ID int `json:"id"`
+
+	// A textual description of the client.
+	Name string `json:"name"`
+
+	// Whether the client is active or archived.
+	IsActive bool `json:"is_active"`
+
+	// The physical address for the client.
+	Address string `json:"address"`
+
+	// Used to build a URL to your client’s invoice dashboard:
+	// https://{ACCOUNT_SUBDOMAIN}.harvestapp.com/client/statements/{STATEMENT_KEY}
+	StatementKey string `json:"statement_key"`
+
+	// The currency code associated with this client.
+	Currency string `json:"currency"`
+
+	// Date and time the client was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the client was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetClientParameters struct {
+	// Pass true to only return active clients and false to return
+	// inactive clients.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return clients that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your clients. The clients are returned sorted by
+// creation date, with the most recently created clients appearing first.
+func (c *Client) GetClients(params GetClientParameters) (ClientResourceResponse, error) {
+	return c.GetClientsWithContext(context.Background(), params)
+}
+
+// GetClients, bound to the provided context.
+func (c *Client) GetClientsWithContext(ctx context.Context, params GetClientParameters) (ClientResourceResponse, error) {
+	cr := ClientResourceResponse{}
+	urlTail, err := buildPathWithParams[GetClientParameters]("/v2/clients", params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every client matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterClients(ctx context.Context, params GetClientParameters) iter.Seq2[ClientResource, error] {
+	urlTail, err := buildPathWithParams[GetClientParameters]("/v2/clients", params)
+	if err != nil {
+		return errorSeq[ClientResource](err)
+	}
+	return Iterate[ClientResource, ClientResourceResponse](ctx, c, urlTail)
+}
+
+// Retrieves the client with the given ID. Returns a client object and a
+// 200 OK response code if a valid identifier was provided.
+func (c *Client) GetClient(id int) (ClientResource, error) {
+	return c.GetClientWithContext(context.Background(), id)
+}
+
+// GetClient, bound to the provided context.
+func (c *Client) GetClientWithContext(ctx context.Context, id int) (ClientResource, error) {
+	cl := ClientResource{}
+	urlTail := fmt.Sprintf("/v2/clients/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cl, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cl)
+	if err != nil {
+		return cl, err
+	}
+	return cl, nil
+}
+
+type CreateClientBody struct {
+	// A textual description of the client. - required
+	Name string `json:"name" url:"name,omitempty"`
+
+	// Whether the client is active, or archived. Defaults to
+	// true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// A textual representation of the client’s physical address. May
+	// include new line characters. - optional
+	Address string `json:"address,omitempty" url:"address,omitempty"`
+
+	// The currency used by the client. If not provided, the company’s
+	// currency will be used. - optional
+	Currency string `json:"currency,omitempty" url:"currency,omitempty"`
+}
+
+func (b CreateClientBody) IsValid() bool {
+	return b.Name != ""
+}
+
+// Creates a new client object. Returns a client object and a 201 Created
+// response code if the call succeeded.
+func (c *Client) CreateClient(body CreateClientBody) (ClientResource, error) {
+	return c.CreateClientWithContext(context.Background(), body)
+}
+
+// CreateClient, bound to the provided context.
+func (c *Client) CreateClientWithContext(ctx context.Context, body CreateClientBody) (ClientResource, error) {
+	cl := ClientResource{}
+	if !body.IsValid() {
+		return cl, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/clients", body)
+	if err != nil {
+		return cl, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cl)
+	if err != nil {
+		return cl, err
+	}
+	return cl, nil
+}
+
+type UpdateClientBody struct {
+	// A textual description of the client.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+
+	// Whether the client is active, or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// A textual representation of the client’s physical address. May
+	// include new line characters.
+	Address *string `json:"address,omitempty" url:"address,omitempty"`
+
+	// The currency used by the client.
+	Currency *string `json:"currency,omitempty" url:"currency,omitempty"`
+}
+
+// Updates the specific client by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns a
+// client object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateClient(id int, body UpdateClientBody) (ClientResource, error) {
+	return c.UpdateClientWithContext(context.Background(), id, body)
+}
+
+// UpdateClient, bound to the provided context.
+func (c *Client) UpdateClientWithContext(ctx context.Context, id int, body UpdateClientBody) (ClientResource, error) {
+	cl := ClientResource{}
+	urlTail := fmt.Sprintf("/v2/clients/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return cl, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cl)
+	if err != nil {
+		return cl, err
+	}
+	return cl, nil
+}
+
+// Delete a client. Deleting a client is only possible if it has no
+// projects, invoices, or estimates associated with it. Returns a 200 OK
+// response code if the call succeeded.
+func (c *Client) DeleteClient(id int) error {
+	return c.DeleteClientWithContext(context.Background(), id)
+}
+
+// DeleteClient, bound to the provided context.
+func (c *Client) DeleteClientWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/clients/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
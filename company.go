@@ -1,6 +1,9 @@
 package goharvest
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type Company struct {
 	// The Harvest URL for the company.
@@ -73,11 +76,17 @@ type Company struct {
 // Retrieves the company for the currently authenticated user. Returns a
 // company object and a 200 OK response code.
 func (c *Client) GetCompany() (Company, error) {
+	return c.GetCompanyWithContext(context.Background())
+}
+
+// GetCompany, bound to the provided context.
+func (c *Client) GetCompanyWithContext(ctx context.Context) (Company, error) {
 	company := Company{}
-	res, err := c.Get("/v2/company")
+	res, err := c.GetWithContext(ctx, "/v2/company")
 	if err != nil {
 		return company, err
 	}
+	defer res.Body.Close()
 
 	err = json.NewDecoder(res.Body).Decode(&company)
 	if err != nil {
@@ -98,11 +107,17 @@ type CompanyUpdateParameters struct {
 // parameters not provided will be left unchanged. Returns a company object
 // and a 200 OK response code if the call succeeded.
 func (c *Client) UpdateCompany(params CompanyUpdateParameters) (Company, error) {
+	return c.UpdateCompanyWithContext(context.Background(), params)
+}
+
+// UpdateCompany, bound to the provided context.
+func (c *Client) UpdateCompanyWithContext(ctx context.Context, params CompanyUpdateParameters) (Company, error) {
 	company := Company{}
-	res, err := c.Patch("/v2/company", params)
+	res, err := c.PatchWithContext(ctx, "/v2/company", params)
 	if err != nil {
 		return company, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&company)
 	if err != nil {
 		return company, err
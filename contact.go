@@ -0,0 +1,250 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting contacts
+type ContactResponse struct {
+	Contacts []Contact `json:"contacts"`
+	Pagination
+}
+
+func (cr ContactResponse) Items() []Contact {
+	return cr.Contacts
+}
+
+type ContactClient struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// A contact at one of the company's clients
+type Contact struct {
+	// Unique ID for the contact.
+	ID int `json:"id"`
+
+	// An object containing the contact’s client id and name.
+	Client ContactClient `json:"client"`
+
+	// The title of the contact.
+	Title string `json:"title"`
+
+	// The first name of the contact.
+	FirstName string `json:"first_name"`
+
+	// The last name of the contact.
+	LastName string `json:"last_name"`
+
+	// The contact’s email address.
+	Email string `json:"email"`
+
+	// The contact’s office phone number.
+	PhoneOffice string `json:"phone_office"`
+
+	// The contact’s mobile phone number.
+	PhoneMobile string `json:"phone_mobile"`
+
+	// The contact’s fax number.
+	Fax string `json:"fax"`
+
+	// Date and time the contact was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the contact was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetContactParameters struct {
+	// Only return contacts belonging to the client with the given ID.
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// Only return contacts that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your contacts. The contacts are returned sorted by
+// creation date, with the most recently created contacts appearing first.
+func (c *Client) GetContacts(params GetContactParameters) (ContactResponse, error) {
+	return c.GetContactsWithContext(context.Background(), params)
+}
+
+// GetContacts, bound to the provided context.
+func (c *Client) GetContactsWithContext(ctx context.Context, params GetContactParameters) (ContactResponse, error) {
+	cr := ContactResponse{}
+	urlTail, err := buildPathWithParams[GetContactParameters]("/v2/contacts", params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every contact matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterContacts(ctx context.Context, params GetContactParameters) iter.Seq2[Contact, error] {
+	urlTail, err := buildPathWithParams[GetContactParameters]("/v2/contacts", params)
+	if err != nil {
+		return errorSeq[Contact](err)
+	}
+	return Iterate[Contact, ContactResponse](ctx, c, urlTail)
+}
+
+// Retrieves the contact with the given ID. Returns a contact object and a
+// 200 OK response code if a valid identifier was provided.
+func (c *Client) GetContact(id int) (Contact, error) {
+	return c.GetContactWithContext(context.Background(), id)
+}
+
+// GetContact, bound to the provided context.
+func (c *Client) GetContactWithContext(ctx context.Context, id int) (Contact, error) {
+	co := Contact{}
+	urlTail := fmt.Sprintf("/v2/contacts/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return co, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&co)
+	if err != nil {
+		return co, err
+	}
+	return co, nil
+}
+
+type CreateContactBody struct {
+	// The ID of the client associated with this contact. - required
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// The title of the contact. - optional
+	Title string `json:"title,omitempty" url:"title,omitempty"`
+
+	// The first name of the contact. - required
+	FirstName string `json:"first_name" url:"first_name,omitempty"`
+
+	// The last name of the contact. - optional
+	LastName string `json:"last_name,omitempty" url:"last_name,omitempty"`
+
+	// The contact’s email address. - optional
+	Email string `json:"email,omitempty" url:"email,omitempty"`
+
+	// The contact’s office phone number. - optional
+	PhoneOffice string `json:"phone_office,omitempty" url:"phone_office,omitempty"`
+
+	// The contact’s mobile phone number. - optional
+	PhoneMobile string `json:"phone_mobile,omitempty" url:"phone_mobile,omitempty"`
+
+	// The contact’s fax number. - optional
+	Fax string `json:"fax,omitempty" url:"fax,omitempty"`
+}
+
+func (b CreateContactBody) IsValid() bool {
+	return b.ClientID != 0 && b.FirstName != ""
+}
+
+// Creates a new contact object. Returns a contact object and a 201 Created
+// response code if the call succeeded.
+func (c *Client) CreateContact(body CreateContactBody) (Contact, error) {
+	return c.CreateContactWithContext(context.Background(), body)
+}
+
+// CreateContact, bound to the provided context.
+func (c *Client) CreateContactWithContext(ctx context.Context, body CreateContactBody) (Contact, error) {
+	co := Contact{}
+	if !body.IsValid() {
+		return co, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/contacts", body)
+	if err != nil {
+		return co, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&co)
+	if err != nil {
+		return co, err
+	}
+	return co, nil
+}
+
+type UpdateContactBody struct {
+	// The ID of the client associated with this contact.
+	ClientID *int `json:"client_id,omitempty" url:"client_id,omitempty"`
+
+	// The title of the contact.
+	Title *string `json:"title,omitempty" url:"title,omitempty"`
+
+	// The first name of the contact.
+	FirstName *string `json:"first_name,omitempty" url:"first_name,omitempty"`
+
+	// The last name of the contact.
+	LastName *string `json:"last_name,omitempty" url:"last_name,omitempty"`
+
+	// The contact’s email address.
+	Email *string `json:"email,omitempty" url:"email,omitempty"`
+
+	// The contact’s office phone number.
+	PhoneOffice *string `json:"phone_office,omitempty" url:"phone_office,omitempty"`
+
+	// The contact’s mobile phone number.
+	PhoneMobile *string `json:"phone_mobile,omitempty" url:"phone_mobile,omitempty"`
+
+	// The contact’s fax number.
+	Fax *string `json:"fax,omitempty" url:"fax,omitempty"`
+}
+
+// Updates the specific contact by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns a
+// contact object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateContact(id int, body UpdateContactBody) (Contact, error) {
+	return c.UpdateContactWithContext(context.Background(), id, body)
+}
+
+// UpdateContact, bound to the provided context.
+func (c *Client) UpdateContactWithContext(ctx context.Context, id int, body UpdateContactBody) (Contact, error) {
+	co := Contact{}
+	urlTail := fmt.Sprintf("/v2/contacts/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return co, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&co)
+	if err != nil {
+		return co, err
+	}
+	return co, nil
+}
+
+// Delete a contact. Returns a 200 OK response code if the call succeeded.
+func (c *Client) DeleteContact(id int) error {
+	return c.DeleteContactWithContext(context.Background(), id)
+}
+
+// DeleteContact, bound to the provided context.
+func (c *Client) DeleteContactWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/contacts/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -1,6 +1,7 @@
 package goharvest
 
 import (
+	"net/url"
 	"time"
 )
 
@@ -11,6 +12,10 @@ type Date struct {
 }
 
 func (s *Date) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		s.Time = time.Time{}
+		return nil
+	}
 	newTime, err := time.Parse(time.DateOnly, string(input[1:len(input)-1]))
 	if err != nil {
 		s.Time = time.Time{}
@@ -25,3 +30,13 @@ func (s Date) MarshalJSON() ([]byte, error) {
 	str := s.Format(time.DateOnly)
 	return []byte(`"` + str + `"`), nil
 }
+
+// Encodes the date as a DateOnly string when used in query parameters.
+// Without this, go-querystring treats Date as a nested struct and drops it.
+func (s Date) EncodeValues(key string, v *url.Values) error {
+	if s.IsZero() {
+		return nil
+	}
+	v.Set(key, s.Format(time.DateOnly))
+	return nil
+}
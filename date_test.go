@@ -0,0 +1,43 @@
+package goharvest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestBuildPathWithParamsEncodesDates(t *testing.T) {
+	from := Date{time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)}
+	to := Date{time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name   string
+		params GetTimeEntryParameters
+		want   string
+	}{
+		{"no dates", GetTimeEntryParameters{}, "/v2/time_entries"},
+		{"from and to", GetTimeEntryParameters{From: from, To: to}, "/v2/time_entries?from=2024-03-01&to=2024-03-31"},
+		{"from only", GetTimeEntryParameters{From: from}, "/v2/time_entries?from=2024-03-01"},
+	}
+	for _, tt := range tests {
+		got, err := buildPathWithParams("/v2/time_entries", tt.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	v := struct {
+		Start Date `json:"start"`
+		End   Date `json:"end"`
+	}{}
+	if err := json.Unmarshal([]byte(`{"start":"2024-03-01","end":null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Start.Format(time.DateOnly) != "2024-03-01" || !v.End.IsZero() {
+		t.Fatalf("got start %v and end %v", v.Start, v.End)
+	}
+}
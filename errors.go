@@ -0,0 +1,126 @@
+package goharvest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors for the conditions callers most often need to handle.
+// An APIError matches these with errors.Is, i.e.:
+//
+//	if errors.Is(err, goharvest.ErrNotFound) { ... }
+var (
+	ErrUnauthorized     = errors.New("harvest: unauthorized")
+	ErrForbidden        = errors.New("harvest: forbidden")
+	ErrNotFound         = errors.New("harvest: not found")
+	ErrValidationFailed = errors.New("harvest: validation failed")
+	ErrLocked           = errors.New("harvest: locked")
+	ErrRateLimited      = errors.New("harvest: rate limited")
+)
+
+// An error response from the Harvest API. Harvest reports errors as JSON,
+// using `error` and `error_description` for most failures and `message`
+// for 422 validation failures; whichever of these were present are parsed
+// into the fields below. Use errors.As to retrieve it from an error
+// returned by the Client.
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The HTTP method of the request that failed.
+	Method string
+
+	// The path (and query, if any) of the request that failed, relative to
+	// the client's BasePath.
+	Path string
+
+	// A short machine-readable code describing the error, from the
+	// response's `error` field. I.e., "invalid_token".
+	Code string
+
+	// A human-readable description of the error, from the response's
+	// `error_description` field.
+	Description string
+
+	// The validation message, from the response's `message` field. Harvest
+	// populates this for 422 Unprocessable Entity responses.
+	Message string
+
+	// The raw response body, kept for debugging when the body isn't JSON.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	detail := e.Message
+	if detail == "" {
+		detail = e.Description
+	}
+	if detail == "" {
+		detail = e.Code
+	}
+	if detail == "" {
+		detail = strings.TrimSpace(e.Body)
+	}
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if detail == "" {
+		return fmt.Sprintf("harvest: %s %s: %s", e.Method, e.Path, status)
+	}
+	return fmt.Sprintf("harvest: %s %s: %s: %s", e.Method, e.Path, status, detail)
+}
+
+// Reports whether the error matches one of the package's sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidationFailed:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrLocked:
+		return e.isLocked()
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// Harvest doesn't use a dedicated status for locked or approved time
+// entries; it rejects the change and explains why in the message. We
+// treat 423 Locked, as well as any rejection mentioning a lock, as locked.
+func (e *APIError) isLocked() bool {
+	if e.StatusCode == http.StatusLocked {
+		return true
+	}
+	if e.StatusCode != http.StatusForbidden && e.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	text := strings.ToLower(e.Message + " " + e.Description)
+	return strings.Contains(text, "locked")
+}
+
+// Builds an APIError from a failed response and its already-read body.
+func newAPIError(method string, urlTail string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       urlTail,
+		Body:       string(body),
+	}
+	payload := struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		Message          string `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Code = payload.Error
+		apiErr.Description = payload.ErrorDescription
+		apiErr.Message = payload.Message
+	}
+	return apiErr
+}
@@ -0,0 +1,82 @@
+package goharvest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrValidationFailed, ErrLocked, ErrRateLimited}
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"unauthorized", http.StatusUnauthorized, `{"error":"invalid_token"}`, ErrUnauthorized},
+		{"forbidden", http.StatusForbidden, `{"error":"forbidden"}`, ErrForbidden},
+		{"not found", http.StatusNotFound, `{"error":"not_found"}`, ErrNotFound},
+		{"validation failed", http.StatusUnprocessableEntity, `{"message":"Name can't be blank"}`, ErrValidationFailed},
+		{"rate limited", http.StatusTooManyRequests, ``, ErrRateLimited},
+		{"locked", http.StatusLocked, ``, ErrLocked},
+		{"server error", http.StatusInternalServerError, `oops`, nil},
+	}
+	for _, tt := range tests {
+		err := error(newAPIError(http.MethodGet, "/v2/time_entries/1", tt.status, []byte(tt.body)))
+		for _, s := range sentinels {
+			if got := errors.Is(err, s); got != (s == tt.want) {
+				t.Errorf("%s: errors.Is(err, %v) = %v", tt.name, s, got)
+			}
+		}
+	}
+}
+
+func TestAPIErrorIsLockedByMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{"forbidden mentioning a lock", http.StatusForbidden, `{"error_description":"This time entry is Locked"}`, true},
+		{"validation mentioning a lock", http.StatusUnprocessableEntity, `{"message":"Cannot edit a locked time entry"}`, true},
+		{"validation about something else", http.StatusUnprocessableEntity, `{"message":"Hours can't be blank"}`, false},
+		{"not found mentioning a lock", http.StatusNotFound, `{"message":"locked"}`, false},
+	}
+	for _, tt := range tests {
+		err := newAPIError(http.MethodPatch, "/v2/time_entries/1", tt.status, []byte(tt.body))
+		if got := errors.Is(err, ErrLocked); got != tt.want {
+			t.Errorf("%s: errors.Is(err, ErrLocked) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	// The locked rejections still match their status's sentinel
+	if err := newAPIError(http.MethodPatch, "/v2/time_entries/1", http.StatusUnprocessableEntity, []byte(`{"message":"locked"}`)); !errors.Is(err, ErrValidationFailed) {
+		t.Errorf("a locked 422 should also match ErrValidationFailed")
+	}
+}
+
+func TestAPIErrorError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"message", http.StatusUnprocessableEntity, `{"message":"Name can't be blank","error":"ignored"}`, "harvest: POST /v2/clients: 422 Unprocessable Entity: Name can't be blank"},
+		{"description", http.StatusUnauthorized, `{"error":"invalid_token","error_description":"The access token is invalid"}`, "harvest: POST /v2/clients: 401 Unauthorized: The access token is invalid"},
+		{"code", http.StatusUnauthorized, `{"error":"invalid_token"}`, "harvest: POST /v2/clients: 401 Unauthorized: invalid_token"},
+		{"non-JSON body", http.StatusBadGateway, "<html>Bad Gateway</html>\n", "harvest: POST /v2/clients: 502 Bad Gateway: <html>Bad Gateway</html>"},
+		{"empty body", http.StatusServiceUnavailable, "", "harvest: POST /v2/clients: 503 Service Unavailable"},
+	}
+	for _, tt := range tests {
+		err := newAPIError(http.MethodPost, "/v2/clients", tt.status, []byte(tt.body))
+		if got := err.Error(); got != tt.want {
+			t.Errorf("%s: Error() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	err := newAPIError(http.MethodPost, "/v2/clients", http.StatusBadGateway, []byte("<html>Bad Gateway</html>"))
+	if err.Code != "" || err.Message != "" || err.Body != "<html>Bad Gateway</html>" {
+		t.Errorf("unexpected fields for a non-JSON body: %+v", err)
+	}
+}
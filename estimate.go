@@ -0,0 +1,446 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting estimates
+type EstimateResponse struct {
+	Estimates []Estimate `json:"estimates"`
+	Pagination
+}
+
+func (er EstimateResponse) Items() []Estimate {
+	return er.Estimates
+}
+
+type EstimateClient struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type EstimateCreator struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// A line item on an estimate
+type EstimateLineItem struct {
+	// Unique ID for the line item.
+	ID int `json:"id"`
+
+	// The name of an estimate item category.
+	Kind string `json:"kind"`
+
+	// Text description of the line item.
+	Description string `json:"description"`
+
+	// The unit quantity of the item.
+	Quantity float64 `json:"quantity"`
+
+	// The individual price per unit.
+	UnitPrice float64 `json:"unit_price"`
+
+	// The line item subtotal (quantity * unit_price).
+	Amount float64 `json:"amount"`
+
+	// Whether the estimate’s tax percentage applies to this line item.
+	Taxed bool `json:"taxed"`
+
+	// Whether the estimate’s tax2 percentage applies to this line item.
+	Taxed2 bool `json:"taxed2"`
+}
+
+type Estimate struct {
+	// Unique ID for the estimate.
+	ID int `json:"id"`
+
+	// An object containing estimate’s client id and name.
+	Client EstimateClient `json:"client"`
+
+	// Array of estimate line items.
+	LineItems []EstimateLineItem `json:"line_items"`
+
+	// An object containing the id and name of the person that created
+	// the estimate.
+	Creator EstimateCreator `json:"creator"`
+
+	// Used to build a URL to the public web invoice for your client:
+	// https://{ACCOUNT_SUBDOMAIN}.harvestapp.com/client/estimates/abc123456
+	ClientKey string `json:"client_key"`
+
+	// If no value is set, the number will be automatically generated.
+	Number string `json:"number"`
+
+	// The purchase order number.
+	PurchaseOrder string `json:"purchase_order"`
+
+	// The total amount for the estimate, including any discounts and taxes.
+	Amount float64 `json:"amount"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts.
+	Tax *float64 `json:"tax"`
+
+	// The first amount of tax included, calculated from tax. If no tax is
+	// defined, this value will be null.
+	TaxAmount float64 `json:"tax_amount"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts.
+	Tax2 *float64 `json:"tax2"`
+
+	// The amount calculated from tax2.
+	Tax2Amount float64 `json:"tax2_amount"`
+
+	// This percentage is subtracted from the subtotal.
+	Discount *float64 `json:"discount"`
+
+	// The amount calculated from discount.
+	DiscountAmount float64 `json:"discount_amount"`
+
+	// The estimate subject.
+	Subject string `json:"subject"`
+
+	// Any additional notes included on the estimate.
+	Notes string `json:"notes"`
+
+	// The currency code associated with this estimate.
+	Currency string `json:"currency"`
+
+	// The current state of the estimate: draft, sent, accepted,
+	// or declined.
+	State string `json:"state"`
+
+	// Date the estimate was issued.
+	IssueDate *Date `json:"issue_date"`
+
+	// Date and time the estimate was sent.
+	SentAt *time.Time `json:"sent_at"`
+
+	// Date and time the estimate was accepted.
+	AcceptedAt *time.Time `json:"accepted_at"`
+
+	// Date and time the estimate was declined.
+	DeclinedAt *time.Time `json:"declined_at"`
+
+	// Date and time the estimate was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the estimate was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetEstimateParameters struct {
+	// Only return estimates belonging to the client with the given ID.
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// Only return estimates that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// Only return estimates with an issue_date on or after the given date.
+	From Date `json:"from" url:"from,omitempty"`
+
+	// Only return estimates with an issue_date on or before the given date.
+	To Date `json:"to" url:"to,omitempty"`
+
+	// Only return estimates with a state matching the value provided.
+	// Options: draft, sent, accepted, or declined.
+	State string `json:"state" url:"state,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your estimates. The estimates are returned sorted by
+// issue date, with the most recently issued estimates appearing first.
+func (c *Client) GetEstimates(params GetEstimateParameters) (EstimateResponse, error) {
+	return c.GetEstimatesWithContext(context.Background(), params)
+}
+
+// GetEstimates, bound to the provided context.
+func (c *Client) GetEstimatesWithContext(ctx context.Context, params GetEstimateParameters) (EstimateResponse, error) {
+	er := EstimateResponse{}
+	urlTail, err := buildPathWithParams[GetEstimateParameters]("/v2/estimates", params)
+	if err != nil {
+		return er, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return er, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&er)
+	if err != nil {
+		return er, err
+	}
+	return er, nil
+}
+
+// Returns an iterator over every estimate matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterEstimates(ctx context.Context, params GetEstimateParameters) iter.Seq2[Estimate, error] {
+	urlTail, err := buildPathWithParams[GetEstimateParameters]("/v2/estimates", params)
+	if err != nil {
+		return errorSeq[Estimate](err)
+	}
+	return Iterate[Estimate, EstimateResponse](ctx, c, urlTail)
+}
+
+// Retrieves the estimate with the given ID. Returns an estimate object and
+// a 200 OK response code if a valid identifier was provided.
+func (c *Client) GetEstimate(id int) (Estimate, error) {
+	return c.GetEstimateWithContext(context.Background(), id)
+}
+
+// GetEstimate, bound to the provided context.
+func (c *Client) GetEstimateWithContext(ctx context.Context, id int) (Estimate, error) {
+	est := Estimate{}
+	urlTail := fmt.Sprintf("/v2/estimates/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return est, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&est)
+	if err != nil {
+		return est, err
+	}
+	return est, nil
+}
+
+// A line item to include on a new estimate.
+type CreateEstimateLineItem struct {
+	// The name of an estimate item category. - required
+	Kind string `json:"kind" url:"kind,omitempty"`
+
+	// Text description of the line item. - optional
+	Description string `json:"description,omitempty" url:"description,omitempty"`
+
+	// The unit quantity of the item. Defaults to 1. - optional
+	Quantity *float64 `json:"quantity,omitempty" url:"quantity,omitempty"`
+
+	// The individual price per unit. - required
+	UnitPrice *float64 `json:"unit_price" url:"unit_price,omitempty"`
+
+	// Whether the estimate’s tax percentage applies to this line item.
+	// Defaults to false. - optional
+	Taxed bool `json:"taxed,omitempty" url:"taxed,omitempty"`
+
+	// Whether the estimate’s tax2 percentage applies to this line item.
+	// Defaults to false. - optional
+	Taxed2 bool `json:"taxed2,omitempty" url:"taxed2,omitempty"`
+}
+
+type CreateEstimateBody struct {
+	// The ID of the client this estimate belongs to. - required
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// If no value is set, the number will be automatically
+	// generated. - optional
+	Number string `json:"number,omitempty" url:"number,omitempty"`
+
+	// The purchase order number. - optional
+	PurchaseOrder string `json:"purchase_order,omitempty" url:"purchase_order,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%. - optional
+	Tax *float64 `json:"tax,omitempty" url:"tax,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%. - optional
+	Tax2 *float64 `json:"tax2,omitempty" url:"tax2,omitempty"`
+
+	// This percentage is subtracted from the subtotal. Example: use 10.0
+	// for 10.0%. - optional
+	Discount *float64 `json:"discount,omitempty" url:"discount,omitempty"`
+
+	// The estimate subject. - optional
+	Subject string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// Any additional notes to include on the estimate. - optional
+	Notes string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// The currency used by the estimate. If not provided, the client’s
+	// currency will be used. - optional
+	Currency string `json:"currency,omitempty" url:"currency,omitempty"`
+
+	// Date the estimate was issued. Defaults to today’s date. - optional
+	IssueDate *Date `json:"issue_date,omitempty" url:"issue_date,omitempty"`
+
+	// Array of line items. - optional
+	LineItems []CreateEstimateLineItem `json:"line_items,omitempty" url:"line_items,omitempty"`
+}
+
+func (b CreateEstimateBody) IsValid() bool {
+	if b.ClientID == 0 {
+		return false
+	}
+	for _, li := range b.LineItems {
+		if li.Kind == "" || li.UnitPrice == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new estimate object. Returns an estimate object and a 201
+// Created response code if the call succeeded.
+func (c *Client) CreateEstimate(body CreateEstimateBody) (Estimate, error) {
+	return c.CreateEstimateWithContext(context.Background(), body)
+}
+
+// CreateEstimate, bound to the provided context.
+func (c *Client) CreateEstimateWithContext(ctx context.Context, body CreateEstimateBody) (Estimate, error) {
+	est := Estimate{}
+	if !body.IsValid() {
+		return est, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/estimates", body)
+	if err != nil {
+		return est, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&est)
+	if err != nil {
+		return est, err
+	}
+	return est, nil
+}
+
+// A change to one of an estimate's line items. A line item without an ID
+// is added to the estimate; one with an ID updates the existing line item;
+// and one with an ID and Destroy set is removed from the estimate. See
+// DeleteEstimateLineItem.
+type UpdateEstimateLineItem struct {
+	// Unique ID for the line item. Omit to add a new line item.
+	ID *int `json:"id,omitempty" url:"id,omitempty"`
+
+	// The name of an estimate item category. Required for new line items.
+	Kind *string `json:"kind,omitempty" url:"kind,omitempty"`
+
+	// Text description of the line item.
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
+
+	// The unit quantity of the item.
+	Quantity *float64 `json:"quantity,omitempty" url:"quantity,omitempty"`
+
+	// The individual price per unit. Required for new line items.
+	UnitPrice *float64 `json:"unit_price,omitempty" url:"unit_price,omitempty"`
+
+	// Whether the estimate’s tax percentage applies to this line item.
+	Taxed *bool `json:"taxed,omitempty" url:"taxed,omitempty"`
+
+	// Whether the estimate’s tax2 percentage applies to this line item.
+	Taxed2 *bool `json:"taxed2,omitempty" url:"taxed2,omitempty"`
+
+	// Removes the line item with the given ID from the estimate.
+	Destroy bool `json:"_destroy,omitempty" url:"_destroy,omitempty"`
+}
+
+// Returns the line item change that removes the line item with the given
+// ID from an estimate.
+func DeleteEstimateLineItem(id int) UpdateEstimateLineItem {
+	return UpdateEstimateLineItem{ID: &id, Destroy: true}
+}
+
+type UpdateEstimateBody struct {
+	// The ID of the client this estimate belongs to.
+	ClientID *int `json:"client_id,omitempty" url:"client_id,omitempty"`
+
+	// If no value is set, the number will be automatically generated.
+	Number *string `json:"number,omitempty" url:"number,omitempty"`
+
+	// The purchase order number.
+	PurchaseOrder *string `json:"purchase_order,omitempty" url:"purchase_order,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%.
+	Tax *float64 `json:"tax,omitempty" url:"tax,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%.
+	Tax2 *float64 `json:"tax2,omitempty" url:"tax2,omitempty"`
+
+	// This percentage is subtracted from the subtotal. Example: use 10.0
+	// for 10.0%.
+	Discount *float64 `json:"discount,omitempty" url:"discount,omitempty"`
+
+	// The estimate subject.
+	Subject *string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// Any additional notes to include on the estimate.
+	Notes *string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// The currency used by the estimate.
+	Currency *string `json:"currency,omitempty" url:"currency,omitempty"`
+
+	// Date the estimate was issued.
+	IssueDate *Date `json:"issue_date,omitempty" url:"issue_date,omitempty"`
+
+	// Line items to add, update, or remove. Line items not listed are left
+	// unchanged. See UpdateEstimateLineItem.
+	LineItems []UpdateEstimateLineItem `json:"line_items,omitempty" url:"line_items,omitempty"`
+}
+
+func (b UpdateEstimateBody) IsValid() bool {
+	for _, li := range b.LineItems {
+		if li.Destroy && li.ID == nil {
+			return false
+		}
+		if li.ID == nil && (li.Kind == nil || li.UnitPrice == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// Updates the specific estimate by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns an
+// estimate object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateEstimate(id int, body UpdateEstimateBody) (Estimate, error) {
+	return c.UpdateEstimateWithContext(context.Background(), id, body)
+}
+
+// UpdateEstimate, bound to the provided context.
+func (c *Client) UpdateEstimateWithContext(ctx context.Context, id int, body UpdateEstimateBody) (Estimate, error) {
+	est := Estimate{}
+	if !body.IsValid() {
+		return est, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/estimates/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return est, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&est)
+	if err != nil {
+		return est, err
+	}
+	return est, nil
+}
+
+// Delete an estimate. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteEstimate(id int) error {
+	return c.DeleteEstimateWithContext(context.Background(), id)
+}
+
+// DeleteEstimate, bound to the provided context.
+func (c *Client) DeleteEstimateWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/estimates/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
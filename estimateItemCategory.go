@@ -0,0 +1,197 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting estimate item categories
+type EstimateItemCategoryResponse struct {
+	EstimateItemCategories []EstimateItemCategory `json:"estimate_item_categories"`
+	Pagination
+}
+
+func (cr EstimateItemCategoryResponse) Items() []EstimateItemCategory {
+	return cr.EstimateItemCategories
+}
+
+// An estimate item category, used to classify line items
+type EstimateItemCategory struct {
+	// Unique ID for the estimate item category.
+	ID int `json:"id"`
+
+	// The name of the estimate item category.
+	Name string `json:"name"`
+
+	// Whether this estimate item category is used for billable hours when
+	// generating an estimate.
+	UseAsService bool `json:"use_as_service"`
+
+	// Whether this estimate item category is used for expenses when
+	// generating an estimate.
+	UseAsExpense bool `json:"use_as_expense"`
+
+	// Date and time the estimate item category was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the estimate item category was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetEstimateItemCategoryParameters struct {
+	// Only return estimate item categories that have been updated
+	// since the given date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your estimate item categories. They are returned
+// sorted by creation date, with the most recently created appearing first.
+func (c *Client) GetEstimateItemCategories(params GetEstimateItemCategoryParameters) (EstimateItemCategoryResponse, error) {
+	return c.GetEstimateItemCategoriesWithContext(context.Background(), params)
+}
+
+// GetEstimateItemCategories, bound to the provided context.
+func (c *Client) GetEstimateItemCategoriesWithContext(ctx context.Context, params GetEstimateItemCategoryParameters) (EstimateItemCategoryResponse, error) {
+	cr := EstimateItemCategoryResponse{}
+	urlTail, err := buildPathWithParams[GetEstimateItemCategoryParameters]("/v2/estimate_item_categories", params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every estimate item category matching the
+// parameters, requesting each page as it is needed. See Iterate.
+func (c *Client) IterEstimateItemCategories(ctx context.Context, params GetEstimateItemCategoryParameters) iter.Seq2[EstimateItemCategory, error] {
+	urlTail, err := buildPathWithParams[GetEstimateItemCategoryParameters]("/v2/estimate_item_categories", params)
+	if err != nil {
+		return errorSeq[EstimateItemCategory](err)
+	}
+	return Iterate[EstimateItemCategory, EstimateItemCategoryResponse](ctx, c, urlTail)
+}
+
+// Retrieves the estimate item category with the given ID. Returns an
+// estimate item category object and a 200 OK response code if a valid
+// identifier was provided.
+func (c *Client) GetEstimateItemCategory(id int) (EstimateItemCategory, error) {
+	return c.GetEstimateItemCategoryWithContext(context.Background(), id)
+}
+
+// GetEstimateItemCategory, bound to the provided context.
+func (c *Client) GetEstimateItemCategoryWithContext(ctx context.Context, id int) (EstimateItemCategory, error) {
+	ic := EstimateItemCategory{}
+	urlTail := fmt.Sprintf("/v2/estimate_item_categories/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+type CreateEstimateItemCategoryBody struct {
+	// The name of the estimate item category. - required
+	Name string `json:"name" url:"name,omitempty"`
+}
+
+func (b CreateEstimateItemCategoryBody) IsValid() bool {
+	return b.Name != ""
+}
+
+// Creates a new estimate item category object. Returns an estimate item
+// category object and a 201 Created response code if the call succeeded.
+func (c *Client) CreateEstimateItemCategory(body CreateEstimateItemCategoryBody) (EstimateItemCategory, error) {
+	return c.CreateEstimateItemCategoryWithContext(context.Background(), body)
+}
+
+// CreateEstimateItemCategory, bound to the provided context.
+func (c *Client) CreateEstimateItemCategoryWithContext(ctx context.Context, body CreateEstimateItemCategoryBody) (EstimateItemCategory, error) {
+	ic := EstimateItemCategory{}
+	if !body.IsValid() {
+		return ic, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/estimate_item_categories", body)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+type UpdateEstimateItemCategoryBody struct {
+	// The name of the estimate item category.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+}
+
+func (b UpdateEstimateItemCategoryBody) IsValid() bool {
+	return b.Name == nil || *b.Name != ""
+}
+
+// Updates the specific estimate item category by setting the values of the
+// parameters passed. Any parameters not provided will be left unchanged.
+// Returns an estimate item category object and a 200 OK response code if the
+// call succeeded.
+func (c *Client) UpdateEstimateItemCategory(id int, body UpdateEstimateItemCategoryBody) (EstimateItemCategory, error) {
+	return c.UpdateEstimateItemCategoryWithContext(context.Background(), id, body)
+}
+
+// UpdateEstimateItemCategory, bound to the provided context.
+func (c *Client) UpdateEstimateItemCategoryWithContext(ctx context.Context, id int, body UpdateEstimateItemCategoryBody) (EstimateItemCategory, error) {
+	ic := EstimateItemCategory{}
+	if !body.IsValid() {
+		return ic, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/estimate_item_categories/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+// Delete an estimate item category. Returns a 200 OK response code if the
+// call succeeded.
+func (c *Client) DeleteEstimateItemCategory(id int) error {
+	return c.DeleteEstimateItemCategoryWithContext(context.Background(), id)
+}
+
+// DeleteEstimateItemCategory, bound to the provided context.
+func (c *Client) DeleteEstimateItemCategoryWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/estimate_item_categories/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,258 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// The event types that can be recorded on an estimate by creating an
+// estimate message. A message without an event type is sent by email.
+const (
+	// Marks a draft estimate as sent, without emailing it.
+	EstimateEventSend = "send"
+
+	// Marks a sent estimate as accepted.
+	EstimateEventAccept = "accept"
+
+	// Marks a sent estimate as declined.
+	EstimateEventDecline = "decline"
+
+	// Re-opens a closed estimate.
+	EstimateEventReopen = "re-open"
+)
+
+// A response object from requesting estimate messages
+type EstimateMessageResponse struct {
+	EstimateMessages []EstimateMessage `json:"estimate_messages"`
+	Pagination
+}
+
+func (mr EstimateMessageResponse) Items() []EstimateMessage {
+	return mr.EstimateMessages
+}
+
+type EstimateMessageRecipient struct {
+	// Name of the message recipient.
+	Name string `json:"name,omitempty" url:"name,omitempty"`
+
+	// Email of the message recipient.
+	Email string `json:"email" url:"email,omitempty"`
+}
+
+// A message sent for an estimate, or an event recorded on it
+type EstimateMessage struct {
+	// Unique ID for the message.
+	ID int `json:"id"`
+
+	// Name of the user that created the message.
+	SentBy string `json:"sent_by"`
+
+	// Email of the user that created the message.
+	SentByEmail string `json:"sent_by_email"`
+
+	// Name of the user that the message was sent from.
+	SentFrom string `json:"sent_from"`
+
+	// Email of the user that the message was sent from.
+	SentFromEmail string `json:"sent_from_email"`
+
+	// Array of message recipients.
+	Recipients []EstimateMessageRecipient `json:"recipients"`
+
+	// The message subject.
+	Subject string `json:"subject"`
+
+	// The message body.
+	Body string `json:"body"`
+
+	// Whether to email a copy of the message to the current user.
+	SendMeACopy bool `json:"send_me_a_copy"`
+
+	// The type of estimate event that occurred with the message: send,
+	// accept, decline, re-open, view, or invoice.
+	EventType string `json:"event_type"`
+
+	// Date and time the message was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the message was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetEstimateMessageParameters struct {
+	// Only return estimate messages that have been updated since the given
+	// date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of messages associated with a given estimate. The
+// estimate messages are returned sorted by creation date, with the most
+// recently created messages appearing first.
+func (c *Client) GetEstimateMessages(estimateID int, params GetEstimateMessageParameters) (EstimateMessageResponse, error) {
+	return c.GetEstimateMessagesWithContext(context.Background(), estimateID, params)
+}
+
+// GetEstimateMessages, bound to the provided context.
+func (c *Client) GetEstimateMessagesWithContext(ctx context.Context, estimateID int, params GetEstimateMessageParameters) (EstimateMessageResponse, error) {
+	mr := EstimateMessageResponse{}
+	urlTail, err := buildPathWithParams[GetEstimateMessageParameters](fmt.Sprintf("/v2/estimates/%d/messages", estimateID), params)
+	if err != nil {
+		return mr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return mr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&mr)
+	if err != nil {
+		return mr, err
+	}
+	return mr, nil
+}
+
+// Returns an iterator over every message associated with a given
+// estimate, requesting each page as it is needed. See Iterate.
+func (c *Client) IterEstimateMessages(ctx context.Context, estimateID int, params GetEstimateMessageParameters) iter.Seq2[EstimateMessage, error] {
+	urlTail, err := buildPathWithParams[GetEstimateMessageParameters](fmt.Sprintf("/v2/estimates/%d/messages", estimateID), params)
+	if err != nil {
+		return errorSeq[EstimateMessage](err)
+	}
+	return Iterate[EstimateMessage, EstimateMessageResponse](ctx, c, urlTail)
+}
+
+// The body required to create an estimate message. Without an EventType,
+// the message is emailed to its Recipients, of which there must be at
+// least one, each with an email address. With an EventType, the event is
+// recorded on the estimate and nothing is emailed; see
+// MarkEstimateAsSent, AcceptEstimate, DeclineEstimate, and ReopenEstimate.
+type CreateEstimateMessageBody struct {
+	// One of send, accept, decline, or re-open. Leave empty to email the
+	// message. - optional
+	EventType string `json:"event_type,omitempty" url:"event_type,omitempty"`
+
+	// Array of recipient parameters. - required when emailing
+	Recipients []EstimateMessageRecipient `json:"recipients,omitempty" url:"recipients,omitempty"`
+
+	// The message subject. - optional
+	Subject string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// The message body. - optional
+	Body string `json:"body,omitempty" url:"body,omitempty"`
+
+	// If set to true, a copy of the message email will be sent to the
+	// current user. Defaults to false. - optional
+	SendMeACopy bool `json:"send_me_a_copy,omitempty" url:"send_me_a_copy,omitempty"`
+}
+
+func (b CreateEstimateMessageBody) IsValid() bool {
+	switch b.EventType {
+	case EstimateEventSend, EstimateEventAccept, EstimateEventDecline, EstimateEventReopen:
+		return true
+	case "":
+	default:
+		return false
+	}
+	if len(b.Recipients) == 0 {
+		return false
+	}
+	for _, r := range b.Recipients {
+		if r.Email == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new estimate message object. Returns an estimate message
+// object and a 201 Created response code if the call succeeded.
+func (c *Client) CreateEstimateMessage(estimateID int, body CreateEstimateMessageBody) (EstimateMessage, error) {
+	return c.CreateEstimateMessageWithContext(context.Background(), estimateID, body)
+}
+
+// CreateEstimateMessage, bound to the provided context.
+func (c *Client) CreateEstimateMessageWithContext(ctx context.Context, estimateID int, body CreateEstimateMessageBody) (EstimateMessage, error) {
+	em := EstimateMessage{}
+	if !body.IsValid() {
+		return em, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/estimates/%d/messages", estimateID)
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return em, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&em)
+	if err != nil {
+		return em, err
+	}
+	return em, nil
+}
+
+// Marks a draft estimate as sent, without emailing it. Returns the
+// recorded estimate message.
+func (c *Client) MarkEstimateAsSent(estimateID int) (EstimateMessage, error) {
+	return c.MarkEstimateAsSentWithContext(context.Background(), estimateID)
+}
+
+// MarkEstimateAsSent, bound to the provided context.
+func (c *Client) MarkEstimateAsSentWithContext(ctx context.Context, estimateID int) (EstimateMessage, error) {
+	return c.CreateEstimateMessageWithContext(ctx, estimateID, CreateEstimateMessageBody{EventType: EstimateEventSend})
+}
+
+// Marks an open estimate as accepted. Returns the recorded estimate
+// message.
+func (c *Client) AcceptEstimate(estimateID int) (EstimateMessage, error) {
+	return c.AcceptEstimateWithContext(context.Background(), estimateID)
+}
+
+// AcceptEstimate, bound to the provided context.
+func (c *Client) AcceptEstimateWithContext(ctx context.Context, estimateID int) (EstimateMessage, error) {
+	return c.CreateEstimateMessageWithContext(ctx, estimateID, CreateEstimateMessageBody{EventType: EstimateEventAccept})
+}
+
+// Marks an open estimate as declined. Returns the recorded estimate
+// message.
+func (c *Client) DeclineEstimate(estimateID int) (EstimateMessage, error) {
+	return c.DeclineEstimateWithContext(context.Background(), estimateID)
+}
+
+// DeclineEstimate, bound to the provided context.
+func (c *Client) DeclineEstimateWithContext(ctx context.Context, estimateID int) (EstimateMessage, error) {
+	return c.CreateEstimateMessageWithContext(ctx, estimateID, CreateEstimateMessageBody{EventType: EstimateEventDecline})
+}
+
+// Re-opens a closed estimate. Returns the recorded estimate message.
+func (c *Client) ReopenEstimate(estimateID int) (EstimateMessage, error) {
+	return c.ReopenEstimateWithContext(context.Background(), estimateID)
+}
+
+// ReopenEstimate, bound to the provided context.
+func (c *Client) ReopenEstimateWithContext(ctx context.Context, estimateID int) (EstimateMessage, error) {
+	return c.CreateEstimateMessageWithContext(ctx, estimateID, CreateEstimateMessageBody{EventType: EstimateEventReopen})
+}
+
+// Delete an estimate message. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteEstimateMessage(estimateID int, messageID int) error {
+	return c.DeleteEstimateMessageWithContext(context.Background(), estimateID, messageID)
+}
+
+// DeleteEstimateMessage, bound to the provided context.
+func (c *Client) DeleteEstimateMessageWithContext(ctx context.Context, estimateID int, messageID int) error {
+	urlTail := fmt.Sprintf("/v2/estimates/%d/messages/%d", estimateID, messageID)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,26 @@
+package goharvest
+
+import "testing"
+
+func TestCreateEstimateMessageBodyIsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		body CreateEstimateMessageBody
+		want bool
+	}{
+		{"email", CreateEstimateMessageBody{Recipients: []EstimateMessageRecipient{{Email: "a@example.com"}}}, true},
+		{"email without recipients", CreateEstimateMessageBody{}, false},
+		{"recipient without email", CreateEstimateMessageBody{Recipients: []EstimateMessageRecipient{{Name: "A"}}}, false},
+		{"send", CreateEstimateMessageBody{EventType: EstimateEventSend}, true},
+		{"accept", CreateEstimateMessageBody{EventType: EstimateEventAccept}, true},
+		{"decline", CreateEstimateMessageBody{EventType: EstimateEventDecline}, true},
+		{"re-open", CreateEstimateMessageBody{EventType: EstimateEventReopen}, true},
+		{"misspelled event", CreateEstimateMessageBody{EventType: "reopen"}, false},
+		{"unknown event with recipients", CreateEstimateMessageBody{EventType: "invoice", Recipients: []EstimateMessageRecipient{{Email: "a@example.com"}}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.body.IsValid(); got != tt.want {
+			t.Errorf("%s: IsValid() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package goharvest
+
+import "testing"
+
+func TestCreateEstimateBodyIsValid(t *testing.T) {
+	price := 100.0
+	zero := 0.0
+	tests := []struct {
+		name string
+		body CreateEstimateBody
+		want bool
+	}{
+		{"client only", CreateEstimateBody{ClientID: 1}, true},
+		{"missing client", CreateEstimateBody{}, false},
+		{"line item", CreateEstimateBody{ClientID: 1, LineItems: []CreateEstimateLineItem{{Kind: "Service", UnitPrice: &price}}}, true},
+		{"line item at no charge", CreateEstimateBody{ClientID: 1, LineItems: []CreateEstimateLineItem{{Kind: "Service", UnitPrice: &zero}}}, true},
+		{"line item without kind", CreateEstimateBody{ClientID: 1, LineItems: []CreateEstimateLineItem{{UnitPrice: &price}}}, false},
+		{"line item without unit price", CreateEstimateBody{ClientID: 1, LineItems: []CreateEstimateLineItem{{Kind: "Service"}}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.body.IsValid(); got != tt.want {
+			t.Errorf("%s: IsValid() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
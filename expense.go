@@ -0,0 +1,405 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting expenses
+type ExpenseResponse struct {
+	Expenses []Expense `json:"expenses"`
+	Pagination
+}
+
+func (er ExpenseResponse) Items() []Expense {
+	return er.Expenses
+}
+
+type ExpenseClient struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+}
+
+type ExpenseProject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+type ExpenseExpenseCategory struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	UnitPrice *float64 `json:"unit_price"`
+	UnitName  string   `json:"unit_name"`
+}
+
+type ExpenseUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ExpenseReceipt struct {
+	URL         string `json:"url"`
+	FileName    string `json:"file_name"`
+	FileSize    int    `json:"file_size"`
+	ContentType string `json:"content_type"`
+}
+
+type ExpenseInvoice struct {
+	ID     int    `json:"id"`
+	Number string `json:"number"`
+}
+
+type Expense struct {
+	// Unique ID for the expense.
+	ID int `json:"id"`
+
+	// An object containing the expense’s client id, name, and currency.
+	Client ExpenseClient `json:"client"`
+
+	// An object containing the expense’s project id, name, and code.
+	Project ExpenseProject `json:"project"`
+
+	// An object containing the expense’s expense category id, name,
+	// unit_price, and unit_name.
+	ExpenseCategory ExpenseExpenseCategory `json:"expense_category"`
+
+	// An object containing the id and name of the user that recorded
+	// the expense.
+	User ExpenseUser `json:"user"`
+
+	// A user assignment object of the user that recorded the expense.
+	UserAssignment UserAssignment `json:"user_assignment"`
+
+	// An object containing the expense’s receipt URL and file name.
+	Receipt *ExpenseReceipt `json:"receipt"`
+
+	// Once the expense has been invoiced, this field will include the
+	// associated invoice’s id and number.
+	Invoice *ExpenseInvoice `json:"invoice"`
+
+	// Textual notes used to describe the expense.
+	Notes string `json:"notes"`
+
+	// The quantity of units used to calculate the total_cost of
+	// the expense.
+	Units *float64 `json:"units"`
+
+	// The total amount of the expense.
+	TotalCost float64 `json:"total_cost"`
+
+	// Whether the expense is billable or not.
+	Billable bool `json:"billable"`
+
+	// Whether the expense has been approved or not.
+	IsClosed bool `json:"is_closed"`
+
+	// Whether the expense has been been invoiced, approved, or the project
+	// or person related to the expense is archived.
+	IsLocked bool `json:"is_locked"`
+
+	// Whether or not the expense has been marked as invoiced.
+	IsBilled bool `json:"is_billed"`
+
+	// An explanation of why the expense has been locked.
+	LockedReason string `json:"locked_reason"`
+
+	// Date the expense occurred.
+	SpentDate Date `json:"spent_date"`
+
+	// Date and time the expense was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the expense was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetExpenseParameters struct {
+	// Only return expenses belonging to the user with the given ID.
+	UserID int `json:"user_id" url:"user_id,omitempty"`
+
+	// Only return expenses belonging to the client with the given ID.
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// Only return expenses belonging to the project with the given ID.
+	ProjectID int `json:"project_id" url:"project_id,omitempty"`
+
+	// Pass true to only return expenses that have been invoiced and false
+	// to return expenses that have not been invoiced.
+	IsBilled *bool `json:"is_billed" url:"is_billed,omitempty"`
+
+	// Only return expenses with an approval_status matching the value
+	// provided. Options: unsubmitted, submitted, or approved.
+	ApprovalStatus string `json:"approval_status" url:"approval_status,omitempty"`
+
+	// Only return expenses that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// Only return expenses with a spent_date on or after the given date.
+	From Date `json:"from" url:"from,omitempty"`
+
+	// Only return expenses with a spent_date on or before the given date.
+	To Date `json:"to" url:"to,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your expenses. If accessing this endpoint as an Admin,
+// all expenses in the account will be returned. The expenses are returned
+// sorted by the spent_at date, with the most recent expenses
+// appearing first.
+func (c *Client) GetExpenses(params GetExpenseParameters) (ExpenseResponse, error) {
+	return c.GetExpensesWithContext(context.Background(), params)
+}
+
+// GetExpenses, bound to the provided context.
+func (c *Client) GetExpensesWithContext(ctx context.Context, params GetExpenseParameters) (ExpenseResponse, error) {
+	er := ExpenseResponse{}
+	urlTail, err := buildPathWithParams[GetExpenseParameters]("/v2/expenses", params)
+	if err != nil {
+		return er, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return er, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&er)
+	if err != nil {
+		return er, err
+	}
+	return er, nil
+}
+
+// Returns an iterator over every expense matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterExpenses(ctx context.Context, params GetExpenseParameters) iter.Seq2[Expense, error] {
+	urlTail, err := buildPathWithParams[GetExpenseParameters]("/v2/expenses", params)
+	if err != nil {
+		return errorSeq[Expense](err)
+	}
+	return Iterate[Expense, ExpenseResponse](ctx, c, urlTail)
+}
+
+// Retrieves the expense with the given ID. Returns an expense object and a
+// 200 OK response code if a valid identifier was provided.
+func (c *Client) GetExpense(id int) (Expense, error) {
+	return c.GetExpenseWithContext(context.Background(), id)
+}
+
+// GetExpense, bound to the provided context.
+func (c *Client) GetExpenseWithContext(ctx context.Context, id int) (Expense, error) {
+	ex := Expense{}
+	urlTail := fmt.Sprintf("/v2/expenses/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ex, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ex)
+	if err != nil {
+		return ex, err
+	}
+	return ex, nil
+}
+
+type CreateExpenseBody struct {
+	// The ID of the user associated with this expense. Defaults to the ID
+	// of the currently authenticated user. - optional
+	UserID int `json:"user_id,omitempty" url:"user_id,omitempty"`
+
+	// The ID of the project associated with this expense. - required
+	ProjectID int `json:"project_id" url:"project_id,omitempty"`
+
+	// The ID of the expense category this expense is being tracked
+	// against. - required
+	ExpenseCategoryID int `json:"expense_category_id" url:"expense_category_id,omitempty"`
+
+	// Date the expense occurred. - required
+	SpentDate Date `json:"spent_date" url:"spent_date,omitempty"`
+
+	// The quantity of units to use in calculating the total_cost of the
+	// expense. - optional
+	Units *float64 `json:"units,omitempty" url:"units,omitempty"`
+
+	// The total amount of the expense. - optional
+	TotalCost *float64 `json:"total_cost,omitempty" url:"total_cost,omitempty"`
+
+	// Textual notes used to describe the expense. - optional
+	Notes string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// Whether this expense is billable or not. Defaults to true. - optional
+	Billable *bool `json:"billable,omitempty" url:"billable,omitempty"`
+
+	// A receipt file to attach to the expense. When set, the expense is
+	// sent as multipart/form-data. - optional
+	Receipt *Receipt `json:"-" url:"-"`
+}
+
+func (b CreateExpenseBody) IsValid() bool {
+	return b.ProjectID != 0 && b.ExpenseCategoryID != 0 && !b.SpentDate.IsZero()
+}
+
+// Sets the expense category and number of units of a unit-priced expense,
+// i.e. mileage, computing the TotalCost from the category's unit price.
+// Returns an error if the category is not priced per unit.
+func (b *CreateExpenseBody) SetUnits(category ExpenseCategory, units float64) error {
+	totalCost, err := category.TotalCost(units)
+	if err != nil {
+		return err
+	}
+	b.ExpenseCategoryID = category.ID
+	b.Units = &units
+	b.TotalCost = &totalCost
+	return nil
+}
+
+// Creates a new expense object. Returns an expense object and a 201
+// Created response code if the call succeeded.
+func (c *Client) CreateExpense(body CreateExpenseBody) (Expense, error) {
+	return c.CreateExpenseWithContext(context.Background(), body)
+}
+
+// CreateExpense, bound to the provided context.
+func (c *Client) CreateExpenseWithContext(ctx context.Context, body CreateExpenseBody) (Expense, error) {
+	ex := Expense{}
+	if !body.IsValid() {
+		return ex, errors.New("Invalid body")
+	}
+	var payload any = body
+	if body.Receipt != nil {
+		mb, err := newMultipartBody(body, "receipt", *body.Receipt)
+		if err != nil {
+			return ex, err
+		}
+		payload = mb
+	}
+	res, err := c.PostWithContext(ctx, "/v2/expenses", payload)
+	if err != nil {
+		return ex, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ex)
+	if err != nil {
+		return ex, err
+	}
+	return ex, nil
+}
+
+type UpdateExpenseBody struct {
+	// The ID of the project associated with this expense.
+	ProjectID *int `json:"project_id,omitempty" url:"project_id,omitempty"`
+
+	// The ID of the expense category this expense is being tracked against.
+	ExpenseCategoryID *int `json:"expense_category_id,omitempty" url:"expense_category_id,omitempty"`
+
+	// Date the expense occurred.
+	SpentDate *Date `json:"spent_date,omitempty" url:"spent_date,omitempty"`
+
+	// The quantity of units to use in calculating the total_cost of
+	// the expense.
+	Units *float64 `json:"units,omitempty" url:"units,omitempty"`
+
+	// The total amount of the expense.
+	TotalCost *float64 `json:"total_cost,omitempty" url:"total_cost,omitempty"`
+
+	// Textual notes used to describe the expense.
+	Notes *string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// Whether this expense is billable or not.
+	Billable *bool `json:"billable,omitempty" url:"billable,omitempty"`
+
+	// A receipt file to attach to the expense, replacing any existing
+	// receipt. When set, the expense is sent as multipart/form-data.
+	Receipt *Receipt `json:"-" url:"-"`
+
+	// Whether an attached expense receipt should be deleted. Cannot be
+	// combined with Receipt.
+	DeleteReceipt *bool `json:"delete_receipt,omitempty" url:"delete_receipt,omitempty"`
+}
+
+func (b UpdateExpenseBody) IsValid() bool {
+	return !(b.Receipt != nil && b.DeleteReceipt != nil && *b.DeleteReceipt)
+}
+
+// Updates the specific expense by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns an
+// expense object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateExpense(id int, body UpdateExpenseBody) (Expense, error) {
+	return c.UpdateExpenseWithContext(context.Background(), id, body)
+}
+
+// UpdateExpense, bound to the provided context.
+func (c *Client) UpdateExpenseWithContext(ctx context.Context, id int, body UpdateExpenseBody) (Expense, error) {
+	ex := Expense{}
+	if !body.IsValid() {
+		return ex, errors.New("Invalid body")
+	}
+	var payload any = body
+	if body.Receipt != nil {
+		mb, err := newMultipartBody(body, "receipt", *body.Receipt)
+		if err != nil {
+			return ex, err
+		}
+		payload = mb
+	}
+	urlTail := fmt.Sprintf("/v2/expenses/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, payload)
+	if err != nil {
+		return ex, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ex)
+	if err != nil {
+		return ex, err
+	}
+	return ex, nil
+}
+
+// Attaches the receipt to the expense with the given ID, replacing any
+// existing receipt. Returns the updated expense.
+func (c *Client) UploadExpenseReceipt(id int, receipt Receipt) (Expense, error) {
+	return c.UploadExpenseReceiptWithContext(context.Background(), id, receipt)
+}
+
+// UploadExpenseReceipt, bound to the provided context.
+func (c *Client) UploadExpenseReceiptWithContext(ctx context.Context, id int, receipt Receipt) (Expense, error) {
+	return c.UpdateExpenseWithContext(ctx, id, UpdateExpenseBody{Receipt: &receipt})
+}
+
+// Removes the receipt attached to the expense with the given ID. Returns
+// the updated expense.
+func (c *Client) DeleteExpenseReceipt(id int) (Expense, error) {
+	return c.DeleteExpenseReceiptWithContext(context.Background(), id)
+}
+
+// DeleteExpenseReceipt, bound to the provided context.
+func (c *Client) DeleteExpenseReceiptWithContext(ctx context.Context, id int) (Expense, error) {
+	deleteReceipt := true
+	return c.UpdateExpenseWithContext(ctx, id, UpdateExpenseBody{DeleteReceipt: &deleteReceipt})
+}
+
+// Delete an expense. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteExpense(id int) error {
+	return c.DeleteExpenseWithContext(context.Background(), id)
+}
+
+// DeleteExpense, bound to the provided context.
+func (c *Client) DeleteExpenseWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/expenses/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
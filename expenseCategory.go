@@ -0,0 +1,233 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"time"
+)
+
+// A response object from requesting expense categories
+type ExpenseCategoryResponse struct {
+	ExpenseCategories []ExpenseCategory `json:"expense_categories"`
+	Pagination
+}
+
+func (cr ExpenseCategoryResponse) Items() []ExpenseCategory {
+	return cr.ExpenseCategories
+}
+
+type ExpenseCategory struct {
+	// Unique ID for the expense category.
+	ID int `json:"id"`
+
+	// The name of the expense category.
+	Name string `json:"name"`
+
+	// The unit name of the expense category, i.e. "mile".
+	UnitName string `json:"unit_name"`
+
+	// The unit price of the expense category. Null unless the category is
+	// priced per unit.
+	UnitPrice *float64 `json:"unit_price"`
+
+	// Whether the expense category is active or archived.
+	IsActive bool `json:"is_active"`
+
+	// Date and time the expense category was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the expense category was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Returns the total cost of the given number of units in this category,
+// rounded to the nearest cent. Returns an error if the category is not
+// priced per unit.
+func (ec ExpenseCategory) TotalCost(units float64) (float64, error) {
+	if ec.UnitPrice == nil {
+		return 0, fmt.Errorf("Expense category %d has no unit price", ec.ID)
+	}
+	return math.Round(units**ec.UnitPrice*100) / 100, nil
+}
+
+type GetExpenseCategoryParameters struct {
+	// Pass true to only return active expense categories and false to
+	// return inactive expense categories.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return expense categories that have been updated since the
+	// given date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your expense categories. The expense categories are
+// returned sorted by creation date, with the most recently created
+// expense categories appearing first.
+func (c *Client) GetExpenseCategories(params GetExpenseCategoryParameters) (ExpenseCategoryResponse, error) {
+	return c.GetExpenseCategoriesWithContext(context.Background(), params)
+}
+
+// GetExpenseCategories, bound to the provided context.
+func (c *Client) GetExpenseCategoriesWithContext(ctx context.Context, params GetExpenseCategoryParameters) (ExpenseCategoryResponse, error) {
+	cr := ExpenseCategoryResponse{}
+	urlTail, err := buildPathWithParams[GetExpenseCategoryParameters]("/v2/expense_categories", params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every expense category matching the
+// parameters, requesting each page as it is needed. See Iterate.
+func (c *Client) IterExpenseCategories(ctx context.Context, params GetExpenseCategoryParameters) iter.Seq2[ExpenseCategory, error] {
+	urlTail, err := buildPathWithParams[GetExpenseCategoryParameters]("/v2/expense_categories", params)
+	if err != nil {
+		return errorSeq[ExpenseCategory](err)
+	}
+	return Iterate[ExpenseCategory, ExpenseCategoryResponse](ctx, c, urlTail)
+}
+
+// Retrieves the expense category with the given ID. Returns an expense
+// category object and a 200 OK response code if a valid identifier
+// was provided.
+func (c *Client) GetExpenseCategory(id int) (ExpenseCategory, error) {
+	return c.GetExpenseCategoryWithContext(context.Background(), id)
+}
+
+// GetExpenseCategory, bound to the provided context.
+func (c *Client) GetExpenseCategoryWithContext(ctx context.Context, id int) (ExpenseCategory, error) {
+	ec := ExpenseCategory{}
+	urlTail := fmt.Sprintf("/v2/expense_categories/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ec, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ec)
+	if err != nil {
+		return ec, err
+	}
+	return ec, nil
+}
+
+type CreateExpenseCategoryBody struct {
+	// The name of the expense category. - required
+	Name string `json:"name" url:"name,omitempty"`
+
+	// The unit name of the expense category, i.e. "mile". - optional
+	UnitName string `json:"unit_name,omitempty" url:"unit_name,omitempty"`
+
+	// The unit price of the expense category. - optional
+	UnitPrice *float64 `json:"unit_price,omitempty" url:"unit_price,omitempty"`
+
+	// Whether the expense category is active or archived. Defaults
+	// to true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+}
+
+func (b CreateExpenseCategoryBody) IsValid() bool {
+	return b.Name != ""
+}
+
+// Creates a new expense category object. Returns an expense category
+// object and a 201 Created response code if the call succeeded.
+func (c *Client) CreateExpenseCategory(body CreateExpenseCategoryBody) (ExpenseCategory, error) {
+	return c.CreateExpenseCategoryWithContext(context.Background(), body)
+}
+
+// CreateExpenseCategory, bound to the provided context.
+func (c *Client) CreateExpenseCategoryWithContext(ctx context.Context, body CreateExpenseCategoryBody) (ExpenseCategory, error) {
+	ec := ExpenseCategory{}
+	if !body.IsValid() {
+		return ec, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/expense_categories", body)
+	if err != nil {
+		return ec, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ec)
+	if err != nil {
+		return ec, err
+	}
+	return ec, nil
+}
+
+type UpdateExpenseCategoryBody struct {
+	// The name of the expense category.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+
+	// The unit name of the expense category, i.e. "mile".
+	UnitName *string `json:"unit_name,omitempty" url:"unit_name,omitempty"`
+
+	// The unit price of the expense category.
+	UnitPrice *float64 `json:"unit_price,omitempty" url:"unit_price,omitempty"`
+
+	// Whether the expense category is active or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+}
+
+func (b UpdateExpenseCategoryBody) IsValid() bool {
+	return b.Name == nil || *b.Name != ""
+}
+
+// Updates the specific expense category by setting the values of the
+// parameters passed. Any parameters not provided will be left unchanged.
+// Returns an expense category object and a 200 OK response code if the
+// call succeeded.
+func (c *Client) UpdateExpenseCategory(id int, body UpdateExpenseCategoryBody) (ExpenseCategory, error) {
+	return c.UpdateExpenseCategoryWithContext(context.Background(), id, body)
+}
+
+// UpdateExpenseCategory, bound to the provided context.
+func (c *Client) UpdateExpenseCategoryWithContext(ctx context.Context, id int, body UpdateExpenseCategoryBody) (ExpenseCategory, error) {
+	ec := ExpenseCategory{}
+	if !body.IsValid() {
+		return ec, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/expense_categories/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ec, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ec)
+	if err != nil {
+		return ec, err
+	}
+	return ec, nil
+}
+
+// Delete an expense category. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteExpenseCategory(id int) error {
+	return c.DeleteExpenseCategoryWithContext(context.Background(), id)
+}
+
+// DeleteExpenseCategory, bound to the provided context.
+func (c *Client) DeleteExpenseCategoryWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/expense_categories/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
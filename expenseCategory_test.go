@@ -0,0 +1,34 @@
+package goharvest
+
+import "testing"
+
+func TestExpenseCategoryTotalCost(t *testing.T) {
+	price := 2.5
+	mileage := 0.655
+	tests := []struct {
+		name     string
+		category ExpenseCategory
+		units    float64
+		want     float64
+	}{
+		{"whole units", ExpenseCategory{UnitPrice: &price}, 4, 10},
+		{"rounded down to the cent", ExpenseCategory{UnitPrice: &price}, 3.333, 8.33},
+		{"rounded up to the cent", ExpenseCategory{UnitPrice: &mileage}, 7, 4.59},
+		{"no units", ExpenseCategory{UnitPrice: &price}, 0, 0},
+	}
+	for _, tt := range tests {
+		got, err := tt.category.TotalCost(tt.units)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: TotalCost(%v) = %v, want %v", tt.name, tt.units, got, tt.want)
+		}
+	}
+}
+
+func TestExpenseCategoryTotalCostWithoutUnitPrice(t *testing.T) {
+	if _, err := (ExpenseCategory{ID: 7, Name: "Meals"}).TotalCost(2); err == nil {
+		t.Fatal("expected an error for a category without a unit price")
+	}
+}
@@ -0,0 +1,28 @@
+package goharvest
+
+import "testing"
+
+func TestCreateExpenseBodySetUnits(t *testing.T) {
+	price := 2.5
+	b := CreateExpenseBody{ProjectID: 10}
+	if err := b.SetUnits(ExpenseCategory{ID: 20, UnitPrice: &price}, 3.333); err != nil {
+		t.Fatal(err)
+	}
+	if b.ExpenseCategoryID != 20 || b.Units == nil || *b.Units != 3.333 || b.TotalCost == nil || *b.TotalCost != 8.33 {
+		t.Fatalf("unexpected body %+v", b)
+	}
+	if b.ProjectID != 10 {
+		t.Fatalf("SetUnits changed ProjectID to %d", b.ProjectID)
+	}
+}
+
+func TestCreateExpenseBodySetUnitsWithoutUnitPrice(t *testing.T) {
+	b := CreateExpenseBody{ExpenseCategoryID: 5}
+	if err := b.SetUnits(ExpenseCategory{ID: 20}, 2); err == nil {
+		t.Fatal("expected an error for a category without a unit price")
+	}
+	// The body is left as it was
+	if b.ExpenseCategoryID != 5 || b.Units != nil || b.TotalCost != nil {
+		t.Fatalf("unexpected body %+v", b)
+	}
+}
@@ -2,9 +2,10 @@ package goharvest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -15,9 +16,10 @@ type Client struct {
 	// documentation site
 	BasePath string
 
-	// The token to use when making requests, provided by the application.
-	// Harvest provides OAuth or PAT style authentication.
-	Token string
+	// Supplies the token to use when making requests. Harvest provides
+	// OAuth or PAT style authentication; use StaticTokenSource for a PAT, or
+	// an OAuthTokenSource to have the token refreshed automatically.
+	TokenSource TokenSource
 
 	// The HTTP Client that does the heavy lifting
 	Client http.Client
@@ -30,89 +32,148 @@ type Client struct {
 	// "MyHarvestApp (https://www.myharvestapp.com/contact)" or
 	// "MyHarvestApp (myemail@email.com)"
 	UserAgent string
+
+	// How requests that are throttled or that fail with a server error are
+	// retried. The zero value disables retries.
+	Retry RetryPolicy
+
+	// Paces requests to the general API on the client side. Shared by every
+	// goroutine using this Client. A nil Limiter disables pacing.
+	Limiter *RateLimiter
+
+	// Paces requests to the Reports API, which Harvest limits separately
+	// and more tightly. A nil ReportsLimiter disables pacing.
+	ReportsLimiter *RateLimiter
+
+	// Additional middlewares every request is sent through, i.e. for
+	// logging, metrics, or header injection. See Middleware and Use.
+	Middlewares []Middleware
+
+	// Logs every request and response when set. See LoggingMiddleware.
+	Logger *slog.Logger
 }
 
 // Create a new Client with the provided token, account ID, and
 // User-Agent string
 func NewClient(PAT string, accountID string, userAgent string) *Client {
+	return NewClientWithTokenSource(StaticTokenSource(PAT), accountID, userAgent)
+}
+
+// Create a new Client that takes its tokens from the provided TokenSource,
+// i.e. an OAuthTokenSource, with the provided account ID and User-Agent
+// string
+func NewClientWithTokenSource(ts TokenSource, accountID string, userAgent string) *Client {
 	return &Client{
-		Token:     PAT,
-		Client:    http.Client{},
-		BasePath:  "https://api.harvestapp.com",
-		AccountID: accountID,
-		UserAgent: userAgent,
+		TokenSource:    ts,
+		Client:         http.Client{},
+		BasePath:       "https://api.harvestapp.com",
+		AccountID:      accountID,
+		UserAgent:      userAgent,
+		Retry:          DefaultRetryPolicy,
+		Limiter:        NewRateLimiter(DefaultRateLimitRequests, DefaultRateLimitInterval),
+		ReportsLimiter: NewRateLimiter(ReportsRateLimitRequests, ReportsRateLimitInterval),
 	}
 }
 
 // Make a GET request to the client's BasePath + the provided urlTail
 func (c *Client) Get(urlTail string) (*http.Response, error) {
-	return c.makeRequest("GET", urlTail, nil)
+	return c.GetWithContext(context.Background(), urlTail)
+}
+
+// Make a GET request to the client's BasePath + the provided urlTail,
+// bound to the provided context.
+func (c *Client) GetWithContext(ctx context.Context, urlTail string) (*http.Response, error) {
+	return c.makeRequest(ctx, "GET", urlTail, nil)
 }
 
 // Make a POST request to the client's BasePath + the provided urlTail,
 // using the provided request body.
 func (c *Client) Post(urlTail string, body any) (*http.Response, error) {
-	return c.makeRequest("POST", urlTail, body)
+	return c.PostWithContext(context.Background(), urlTail, body)
+}
+
+// Make a POST request to the client's BasePath + the provided urlTail,
+// using the provided request body and bound to the provided context.
+func (c *Client) PostWithContext(ctx context.Context, urlTail string, body any) (*http.Response, error) {
+	return c.makeRequest(ctx, "POST", urlTail, body)
 }
 
+// Make a PATCH request to the client's BasePath + the provided urlTail,
+// using the provided request body.
 func (c *Client) Patch(urlTail string, body any) (*http.Response, error) {
-	return c.makeRequest("PATCH", urlTail, body)
+	return c.PatchWithContext(context.Background(), urlTail, body)
+}
+
+// Make a PATCH request to the client's BasePath + the provided urlTail,
+// using the provided request body and bound to the provided context.
+func (c *Client) PatchWithContext(ctx context.Context, urlTail string, body any) (*http.Response, error) {
+	return c.makeRequest(ctx, "PATCH", urlTail, body)
 }
 
+// Make a DELETE request to the client's BasePath + the provided urlTail.
 func (c *Client) Delete(urlTail string) error {
-	_, err := c.makeRequest("DELETE", urlTail, nil)
-	return err
+	return c.DeleteWithContext(context.Background(), urlTail)
+}
+
+// Make a DELETE request to the client's BasePath + the provided urlTail,
+// bound to the provided context.
+func (c *Client) DeleteWithContext(ctx context.Context, urlTail string) error {
+	res, err := c.makeRequest(ctx, "DELETE", urlTail, nil)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
 }
 
 // Creates a new request with the provided method, urlTail, and body,
-// setting the appropriate headers each time.
-func (c *Client) newRequest(method string, urlTail string, body any) (*http.Request, error) {
+// setting the appropriate headers each time. The body is encoded as JSON
+// unless it is a multipartBody, which is sent as-is. The request is bound
+// to the provided context so that cancellation and deadlines reach the
+// transport.
+func (c *Client) newRequest(ctx context.Context, method string, urlTail string, body any) (*http.Request, error) {
 	url := c.BasePath + urlTail
 	var req *http.Request
 	var err error
+	contentType := "application/json"
 	if body == nil {
-		req, err = http.NewRequest(method, url, nil)
+		req, err = http.NewRequestWithContext(ctx, method, url, nil)
+	} else if mb, ok := body.(multipartBody); ok {
+		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewReader(mb.data))
+		contentType = mb.contentType
 	} else {
 		var bodyReader bytes.Buffer
 		err := json.NewEncoder(&bodyReader).Encode(body)
 		if err != nil {
 			return req, err
 		}
-		req, err = http.NewRequest(method, url, &bodyReader)
+		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyReader.Bytes()))
 	}
 	if err != nil {
 		return req, err
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
 	req.Header.Set("Harvest-Account-Id", c.AccountID)
 	req.Header.Set("User-Agent", c.UserAgent)
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	return req, err
 }
 
-// A struct to carry error codes from the response up to the
-// main application
-type ErrorCodeResponse struct {
-	StatusCode int
-	Message    string
-}
-
-func (e ErrorCodeResponse) Error() string {
-	return fmt.Sprintf("Error: %d, %s", e.StatusCode, e.Message)
-}
-
-// Crate and issue a request with the client. This wrapper also checks for
-// any error response codes and creates and returns the appropriate error
-// object.
-func (c *Client) makeRequest(method string, urlTail string, body any) (*http.Response, error) {
-	req, err := c.newRequest(method, urlTail, body)
+// Crate and issue a request with the client, sending it through the
+// middleware chain: the built-in retry, rate-limit, and auth middlewares,
+// followed by the client's own Middlewares. This wrapper also checks for
+// any error response codes and creates and returns the appropriate
+// *APIError.
+func (c *Client) makeRequest(ctx context.Context, method string, urlTail string, body any) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, urlTail, body)
 	if err != nil {
 		return &http.Response{}, err
 	}
 
-	res, err := c.Client.Do(req)
+	res, err := c.handler()(req)
 	if err != nil {
+		if res == nil {
+			res = &http.Response{}
+		}
 		return res, err
 	}
 
@@ -120,11 +181,12 @@ func (c *Client) makeRequest(method string, urlTail string, body any) (*http.Res
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
 		// io.ReadAll() will consume the response body, so we need to re-set it
 		ba, err := io.ReadAll(res.Body)
+		res.Body.Close()
 		res.Body = io.NopCloser(bytes.NewBuffer(ba))
 		if err != nil {
 			return res, err
 		}
-		return res, ErrorCodeResponse{res.StatusCode, string(ba)}
+		return res, newAPIError(method, urlTail, res.StatusCode, ba)
 	}
 
 	return res, nil
@@ -1,6 +1,6 @@
 module github.com/pmwals09/go-harvest
 
-go 1.21.4
+go 1.23
 
 require github.com/google/go-querystring v1.1.0
 
@@ -0,0 +1,22 @@
+package goharvest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Returns a Client pointed at a local server running handler, with pacing
+// disabled and retries fast enough for tests.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c := NewClient("token", "123", "go-harvest tests")
+	c.BasePath = srv.URL
+	c.Limiter = nil
+	c.ReportsLimiter = nil
+	c.Retry = RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	return c
+}
@@ -0,0 +1,624 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting invoices
+type InvoiceResponse struct {
+	Invoices []Invoice `json:"invoices"`
+	Pagination
+}
+
+func (ir InvoiceResponse) Items() []Invoice {
+	return ir.Invoices
+}
+
+type InvoiceClient struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type InvoiceEstimate struct {
+	ID int `json:"id"`
+}
+
+type InvoiceRetainer struct {
+	ID int `json:"id"`
+}
+
+type InvoiceCreator struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type InvoiceLineItemProject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// A line item on an invoice
+type InvoiceLineItem struct {
+	// Unique ID for the line item.
+	ID int `json:"id"`
+
+	// An object containing the associated project’s id, name, and code.
+	Project *InvoiceLineItemProject `json:"project"`
+
+	// The name of an invoice item category.
+	Kind string `json:"kind"`
+
+	// Text description of the line item.
+	Description string `json:"description"`
+
+	// The unit quantity of the item.
+	Quantity float64 `json:"quantity"`
+
+	// The individual price per unit.
+	UnitPrice float64 `json:"unit_price"`
+
+	// The line item subtotal (quantity * unit_price).
+	Amount float64 `json:"amount"`
+
+	// Whether the invoice’s tax percentage applies to this line item.
+	Taxed bool `json:"taxed"`
+
+	// Whether the invoice’s tax2 percentage applies to this line item.
+	Taxed2 bool `json:"taxed2"`
+}
+
+type Invoice struct {
+	// Unique ID for the invoice.
+	ID int `json:"id"`
+
+	// An object containing invoice’s client id and name.
+	Client InvoiceClient `json:"client"`
+
+	// Array of invoice line items.
+	LineItems []InvoiceLineItem `json:"line_items"`
+
+	// An object containing the associated estimate’s id.
+	Estimate *InvoiceEstimate `json:"estimate"`
+
+	// An object containing the associated retainer’s id.
+	Retainer *InvoiceRetainer `json:"retainer"`
+
+	// An object containing the id and name of the person that created
+	// the invoice.
+	Creator InvoiceCreator `json:"creator"`
+
+	// Used to build a URL to the public web invoice for your client by
+	// adding /client/invoices/{CLIENT_KEY} to your account URL
+	// https://{SUBDOMAIN}.harvestapp.com/
+	ClientKey string `json:"client_key"`
+
+	// If no value is set, the number will be automatically generated.
+	Number string `json:"number"`
+
+	// The purchase order number.
+	PurchaseOrder string `json:"purchase_order"`
+
+	// The total amount for the invoice, including any discounts and taxes.
+	Amount float64 `json:"amount"`
+
+	// The total amount due at this time for this invoice.
+	DueAmount float64 `json:"due_amount"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts.
+	Tax *float64 `json:"tax"`
+
+	// The first amount of tax included, calculated from tax. If no tax is
+	// defined, this value will be null.
+	TaxAmount float64 `json:"tax_amount"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts.
+	Tax2 *float64 `json:"tax2"`
+
+	// The amount calculated from tax2.
+	Tax2Amount float64 `json:"tax2_amount"`
+
+	// This percentage is subtracted from the subtotal.
+	Discount *float64 `json:"discount"`
+
+	// The amount calculated from discount.
+	DiscountAmount float64 `json:"discount_amount"`
+
+	// The invoice subject.
+	Subject string `json:"subject"`
+
+	// Any additional notes included on the invoice.
+	Notes string `json:"notes"`
+
+	// The currency code associated with this invoice.
+	Currency string `json:"currency"`
+
+	// The current state of the invoice: draft, open, paid, or closed.
+	State string `json:"state"`
+
+	// Start of the period during which time entries and expenses were added
+	// to this invoice.
+	PeriodStart *Date `json:"period_start"`
+
+	// End of the period during which time entries and expenses were added
+	// to this invoice.
+	PeriodEnd *Date `json:"period_end"`
+
+	// Date the invoice was issued.
+	IssueDate Date `json:"issue_date"`
+
+	// Date the invoice is due.
+	DueDate Date `json:"due_date"`
+
+	// The timeframe in which the invoice should be paid. Options: upon
+	// receipt, net 15, net 30, net 45, net 60, or custom.
+	PaymentTerm string `json:"payment_term"`
+
+	// The list of payment options enabled for the invoice. Options: [ach,
+	// credit_card, paypal]
+	PaymentOptions []string `json:"payment_options"`
+
+	// Date and time the invoice was sent.
+	SentAt *time.Time `json:"sent_at"`
+
+	// Date and time the invoice was paid.
+	PaidAt *time.Time `json:"paid_at"`
+
+	// Date the invoice was paid.
+	PaidDate *Date `json:"paid_date"`
+
+	// Date and time the invoice was closed.
+	ClosedAt *time.Time `json:"closed_at"`
+
+	// Unique ID of the associated recurring invoice.
+	RecurringInvoiceID *int `json:"recurring_invoice_id"`
+
+	// Date and time the invoice was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the invoice was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetInvoiceParameters struct {
+	// Only return invoices belonging to the client with the given ID.
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// Only return invoices associated with the project with the given ID.
+	ProjectID int `json:"project_id" url:"project_id,omitempty"`
+
+	// Only return invoices that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// Only return invoices with an issue_date on or after the given date.
+	From Date `json:"from" url:"from,omitempty"`
+
+	// Only return invoices with an issue_date on or before the given date.
+	To Date `json:"to" url:"to,omitempty"`
+
+	// Only return invoices with a state matching the value provided.
+	// Options: draft, open, paid, or closed.
+	State string `json:"state" url:"state,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your invoices. The invoices are returned sorted by
+// issue date, with the most recently issued invoices appearing first.
+func (c *Client) GetInvoices(params GetInvoiceParameters) (InvoiceResponse, error) {
+	return c.GetInvoicesWithContext(context.Background(), params)
+}
+
+// GetInvoices, bound to the provided context.
+func (c *Client) GetInvoicesWithContext(ctx context.Context, params GetInvoiceParameters) (InvoiceResponse, error) {
+	ir := InvoiceResponse{}
+	urlTail, err := buildPathWithParams[GetInvoiceParameters]("/v2/invoices", params)
+	if err != nil {
+		return ir, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ir, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ir)
+	if err != nil {
+		return ir, err
+	}
+	return ir, nil
+}
+
+// Returns an iterator over every invoice matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterInvoices(ctx context.Context, params GetInvoiceParameters) iter.Seq2[Invoice, error] {
+	urlTail, err := buildPathWithParams[GetInvoiceParameters]("/v2/invoices", params)
+	if err != nil {
+		return errorSeq[Invoice](err)
+	}
+	return Iterate[Invoice, InvoiceResponse](ctx, c, urlTail)
+}
+
+// Retrieves the invoice with the given ID. Returns an invoice object and a
+// 200 OK response code if a valid identifier was provided.
+func (c *Client) GetInvoice(id int) (Invoice, error) {
+	return c.GetInvoiceWithContext(context.Background(), id)
+}
+
+// GetInvoice, bound to the provided context.
+func (c *Client) GetInvoiceWithContext(ctx context.Context, id int) (Invoice, error) {
+	inv := Invoice{}
+	urlTail := fmt.Sprintf("/v2/invoices/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return inv, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&inv)
+	if err != nil {
+		return inv, err
+	}
+	return inv, nil
+}
+
+// A line item to include on a new free-form invoice.
+type CreateInvoiceLineItem struct {
+	// The ID of the project associated with this line item. - optional
+	ProjectID int `json:"project_id,omitempty" url:"project_id,omitempty"`
+
+	// The name of an invoice item category. - required
+	Kind string `json:"kind" url:"kind,omitempty"`
+
+	// Text description of the line item. - optional
+	Description string `json:"description,omitempty" url:"description,omitempty"`
+
+	// The unit quantity of the item. Defaults to 1. - optional
+	Quantity *float64 `json:"quantity,omitempty" url:"quantity,omitempty"`
+
+	// The individual price per unit. - required
+	UnitPrice *float64 `json:"unit_price" url:"unit_price,omitempty"`
+
+	// Whether the invoice’s tax percentage applies to this line item.
+	// Defaults to false. - optional
+	Taxed bool `json:"taxed,omitempty" url:"taxed,omitempty"`
+
+	// Whether the invoice’s tax2 percentage applies to this line item.
+	// Defaults to false. - optional
+	Taxed2 bool `json:"taxed2,omitempty" url:"taxed2,omitempty"`
+}
+
+// Which uninvoiced time entries to import into a new invoice, and how to
+// summarize them.
+type InvoiceTimeImport struct {
+	// How to summarize the time entries per line item. Options: project,
+	// task, people, or detailed. - required
+	SummaryType string `json:"summary_type" url:"summary_type,omitempty"`
+
+	// Start date for included time entries. Must be provided if to is
+	// present. If neither from or to are provided, all unbilled time
+	// entries will be included. - optional
+	From *Date `json:"from,omitempty" url:"from,omitempty"`
+
+	// End date for included time entries. Must be provided if from is
+	// present. - optional
+	To *Date `json:"to,omitempty" url:"to,omitempty"`
+}
+
+// Which uninvoiced expenses to import into a new invoice, and how to
+// summarize them.
+type InvoiceExpenseImport struct {
+	// How to summarize the expenses per line item. Options: project,
+	// category, people, or detailed. - required
+	SummaryType string `json:"summary_type" url:"summary_type,omitempty"`
+
+	// Start date for included expenses. Must be provided if to is present.
+	// If neither from or to are provided, all unbilled expenses will be
+	// included. - optional
+	From *Date `json:"from,omitempty" url:"from,omitempty"`
+
+	// End date for included expenses. Must be provided if from is
+	// present. - optional
+	To *Date `json:"to,omitempty" url:"to,omitempty"`
+
+	// If set to true, a PDF containing an expense report with receipts
+	// will be attached to the invoice. Defaults to false. - optional
+	AttachReceipt bool `json:"attach_receipt,omitempty" url:"attach_receipt,omitempty"`
+}
+
+// Tells Harvest to build an invoice's line items from uninvoiced time and
+// expenses, rather than from line items provided by the caller.
+type InvoiceLineItemsImport struct {
+	// An array of the client’s project IDs you’d like to include time and
+	// expenses from. - required
+	ProjectIDs []int `json:"project_ids" url:"project_ids,omitempty"`
+
+	// The time entries to import. - optional
+	Time *InvoiceTimeImport `json:"time,omitempty" url:"time,omitempty"`
+
+	// The expenses to import. - optional
+	Expenses *InvoiceExpenseImport `json:"expenses,omitempty" url:"expenses,omitempty"`
+}
+
+// The body required to create an invoice. Provide either LineItems, to
+// create a free-form invoice, or LineItemsImport, to have Harvest create
+// the line items from the client's uninvoiced time and expenses.
+type CreateInvoiceBody struct {
+	// The ID of the client this invoice belongs to. - required
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// The ID of the retainer associated with this invoice. - optional
+	RetainerID int `json:"retainer_id,omitempty" url:"retainer_id,omitempty"`
+
+	// The ID of the estimate associated with this invoice. - optional
+	EstimateID int `json:"estimate_id,omitempty" url:"estimate_id,omitempty"`
+
+	// If no value is set, the number will be automatically
+	// generated. - optional
+	Number string `json:"number,omitempty" url:"number,omitempty"`
+
+	// The purchase order number. - optional
+	PurchaseOrder string `json:"purchase_order,omitempty" url:"purchase_order,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%. - optional
+	Tax *float64 `json:"tax,omitempty" url:"tax,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%. - optional
+	Tax2 *float64 `json:"tax2,omitempty" url:"tax2,omitempty"`
+
+	// This percentage is subtracted from the subtotal. Example: use 10.0
+	// for 10.0%. - optional
+	Discount *float64 `json:"discount,omitempty" url:"discount,omitempty"`
+
+	// The invoice subject. - optional
+	Subject string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// Any additional notes to include on the invoice. - optional
+	Notes string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// The currency used by the invoice. If not provided, the client’s
+	// currency will be used. - optional
+	Currency string `json:"currency,omitempty" url:"currency,omitempty"`
+
+	// Date the invoice was issued. Defaults to today’s date. - optional
+	IssueDate *Date `json:"issue_date,omitempty" url:"issue_date,omitempty"`
+
+	// Date the invoice is due. Defaults to the issue_date if no
+	// payment_term is specified. - optional
+	DueDate *Date `json:"due_date,omitempty" url:"due_date,omitempty"`
+
+	// The timeframe in which the invoice should be paid. Defaults to
+	// custom. Options: upon receipt, net 15, net 30, net 45, net 60, or
+	// custom. - optional
+	PaymentTerm string `json:"payment_term,omitempty" url:"payment_term,omitempty"`
+
+	// The payment options available to pay the invoice. Your account must
+	// be configured with the appropriate options under Settings > Integrations
+	// > Online payment to assign them. Options: [ach, credit_card,
+	// paypal] - optional
+	PaymentOptions []string `json:"payment_options,omitempty" url:"payment_options,omitempty"`
+
+	// Array of line items for a free-form invoice. Cannot be combined with
+	// LineItemsImport. - optional
+	LineItems []CreateInvoiceLineItem `json:"line_items,omitempty" url:"line_items,omitempty"`
+
+	// The time and expenses to import as line items. Cannot be combined
+	// with LineItems. - optional
+	LineItemsImport *InvoiceLineItemsImport `json:"line_items_import,omitempty" url:"line_items_import,omitempty"`
+}
+
+func (b CreateInvoiceBody) IsValid() bool {
+	if b.ClientID == 0 {
+		return false
+	}
+	// Free-form line items and imported ones are mutually exclusive
+	if len(b.LineItems) > 0 && b.LineItemsImport != nil {
+		return false
+	}
+	for _, li := range b.LineItems {
+		if li.Kind == "" || li.UnitPrice == nil {
+			return false
+		}
+	}
+	if b.LineItemsImport != nil {
+		imp := b.LineItemsImport
+		if len(imp.ProjectIDs) == 0 {
+			return false
+		}
+		if imp.Time != nil && imp.Time.SummaryType == "" {
+			return false
+		}
+		if imp.Expenses != nil && imp.Expenses.SummaryType == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new invoice object. Returns an invoice object and a 201
+// Created response code if the call succeeded.
+//
+// To create a free-form invoice, provide its LineItems. To create an
+// invoice from the client's uninvoiced time and expenses, provide
+// LineItemsImport instead.
+func (c *Client) CreateInvoice(body CreateInvoiceBody) (Invoice, error) {
+	return c.CreateInvoiceWithContext(context.Background(), body)
+}
+
+// CreateInvoice, bound to the provided context.
+func (c *Client) CreateInvoiceWithContext(ctx context.Context, body CreateInvoiceBody) (Invoice, error) {
+	inv := Invoice{}
+	if !body.IsValid() {
+		return inv, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/invoices", body)
+	if err != nil {
+		return inv, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&inv)
+	if err != nil {
+		return inv, err
+	}
+	return inv, nil
+}
+
+// A change to one of an invoice's line items. A line item without an ID
+// is added to the invoice; one with an ID updates the existing line item;
+// and one with an ID and Destroy set is removed from the invoice. See
+// DeleteInvoiceLineItem.
+type UpdateInvoiceLineItem struct {
+	// Unique ID for the line item. Omit to add a new line item.
+	ID *int `json:"id,omitempty" url:"id,omitempty"`
+
+	// The ID of the project associated with this line item.
+	ProjectID *int `json:"project_id,omitempty" url:"project_id,omitempty"`
+
+	// The name of an invoice item category. Required for new line items.
+	Kind *string `json:"kind,omitempty" url:"kind,omitempty"`
+
+	// Text description of the line item.
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
+
+	// The unit quantity of the item.
+	Quantity *float64 `json:"quantity,omitempty" url:"quantity,omitempty"`
+
+	// The individual price per unit. Required for new line items.
+	UnitPrice *float64 `json:"unit_price,omitempty" url:"unit_price,omitempty"`
+
+	// Whether the invoice’s tax percentage applies to this line item.
+	Taxed *bool `json:"taxed,omitempty" url:"taxed,omitempty"`
+
+	// Whether the invoice’s tax2 percentage applies to this line item.
+	Taxed2 *bool `json:"taxed2,omitempty" url:"taxed2,omitempty"`
+
+	// Removes the line item with the given ID from the invoice.
+	Destroy bool `json:"_destroy,omitempty" url:"_destroy,omitempty"`
+}
+
+// Returns the line item change that removes the line item with the given
+// ID from an invoice.
+func DeleteInvoiceLineItem(id int) UpdateInvoiceLineItem {
+	return UpdateInvoiceLineItem{ID: &id, Destroy: true}
+}
+
+type UpdateInvoiceBody struct {
+	// The ID of the client this invoice belongs to.
+	ClientID *int `json:"client_id,omitempty" url:"client_id,omitempty"`
+
+	// The ID of the retainer associated with this invoice.
+	RetainerID *int `json:"retainer_id,omitempty" url:"retainer_id,omitempty"`
+
+	// The ID of the estimate associated with this invoice.
+	EstimateID *int `json:"estimate_id,omitempty" url:"estimate_id,omitempty"`
+
+	// If no value is set, the number will be automatically generated.
+	Number *string `json:"number,omitempty" url:"number,omitempty"`
+
+	// The purchase order number.
+	PurchaseOrder *string `json:"purchase_order,omitempty" url:"purchase_order,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%.
+	Tax *float64 `json:"tax,omitempty" url:"tax,omitempty"`
+
+	// This percentage is applied to the subtotal, including line items
+	// and discounts. Example: use 10.0 for 10.0%.
+	Tax2 *float64 `json:"tax2,omitempty" url:"tax2,omitempty"`
+
+	// This percentage is subtracted from the subtotal. Example: use 10.0
+	// for 10.0%.
+	Discount *float64 `json:"discount,omitempty" url:"discount,omitempty"`
+
+	// The invoice subject.
+	Subject *string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// Any additional notes to include on the invoice.
+	Notes *string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// The currency used by the invoice.
+	Currency *string `json:"currency,omitempty" url:"currency,omitempty"`
+
+	// Date the invoice was issued.
+	IssueDate *Date `json:"issue_date,omitempty" url:"issue_date,omitempty"`
+
+	// Date the invoice is due.
+	DueDate *Date `json:"due_date,omitempty" url:"due_date,omitempty"`
+
+	// The timeframe in which the invoice should be paid. Options: upon
+	// receipt, net 15, net 30, net 45, net 60, or custom.
+	PaymentTerm *string `json:"payment_term,omitempty" url:"payment_term,omitempty"`
+
+	// The payment options available to pay the invoice. Options: [ach,
+	// credit_card, paypal]
+	PaymentOptions *[]string `json:"payment_options,omitempty" url:"payment_options,omitempty"`
+
+	// Line items to add, update, or remove. Line items not listed are left
+	// unchanged. See UpdateInvoiceLineItem.
+	LineItems []UpdateInvoiceLineItem `json:"line_items,omitempty" url:"line_items,omitempty"`
+}
+
+func (b UpdateInvoiceBody) IsValid() bool {
+	for _, li := range b.LineItems {
+		if li.Destroy && li.ID == nil {
+			return false
+		}
+		if li.ID == nil && (li.Kind == nil || li.UnitPrice == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// Updates the specific invoice by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns an
+// invoice object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateInvoice(id int, body UpdateInvoiceBody) (Invoice, error) {
+	return c.UpdateInvoiceWithContext(context.Background(), id, body)
+}
+
+// UpdateInvoice, bound to the provided context.
+func (c *Client) UpdateInvoiceWithContext(ctx context.Context, id int, body UpdateInvoiceBody) (Invoice, error) {
+	inv := Invoice{}
+	if !body.IsValid() {
+		return inv, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/invoices/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return inv, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&inv)
+	if err != nil {
+		return inv, err
+	}
+	return inv, nil
+}
+
+// Delete an invoice. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteInvoice(id int) error {
+	return c.DeleteInvoiceWithContext(context.Background(), id)
+}
+
+// DeleteInvoice, bound to the provided context.
+func (c *Client) DeleteInvoiceWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/invoices/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,198 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting invoice item categories
+type InvoiceItemCategoryResponse struct {
+	InvoiceItemCategories []InvoiceItemCategory `json:"invoice_item_categories"`
+	Pagination
+}
+
+func (cr InvoiceItemCategoryResponse) Items() []InvoiceItemCategory {
+	return cr.InvoiceItemCategories
+}
+
+// An invoice item category, used to classify line items
+type InvoiceItemCategory struct {
+	// Unique ID for the invoice item category.
+	ID int `json:"id"`
+
+	// The name of the invoice item category.
+	Name string `json:"name"`
+
+	// Whether this invoice item category is used for billable hours when
+	// generating an invoice.
+	UseAsService bool `json:"use_as_service"`
+
+	// Whether this invoice item category is used for expenses when
+	// generating an invoice.
+	UseAsExpense bool `json:"use_as_expense"`
+
+	// Date and time the invoice item category was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the invoice item category was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetInvoiceItemCategoryParameters struct {
+	// Only return invoice item categories that have been updated since
+	// the given date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your invoice item categories. They are returned
+// sorted by creation date, with the most recently created appearing first.
+func (c *Client) GetInvoiceItemCategories(params GetInvoiceItemCategoryParameters) (InvoiceItemCategoryResponse, error) {
+	return c.GetInvoiceItemCategoriesWithContext(context.Background(), params)
+}
+
+// GetInvoiceItemCategories, bound to the provided context.
+func (c *Client) GetInvoiceItemCategoriesWithContext(ctx context.Context, params GetInvoiceItemCategoryParameters) (InvoiceItemCategoryResponse, error) {
+	cr := InvoiceItemCategoryResponse{}
+	urlTail, err := buildPathWithParams[GetInvoiceItemCategoryParameters]("/v2/invoice_item_categories", params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every invoice item category matching the
+// parameters, requesting each page as it is needed. See Iterate.
+func (c *Client) IterInvoiceItemCategories(ctx context.Context, params GetInvoiceItemCategoryParameters) iter.Seq2[InvoiceItemCategory, error] {
+	urlTail, err := buildPathWithParams[GetInvoiceItemCategoryParameters]("/v2/invoice_item_categories", params)
+	if err != nil {
+		return errorSeq[InvoiceItemCategory](err)
+	}
+	return Iterate[InvoiceItemCategory, InvoiceItemCategoryResponse](ctx, c, urlTail)
+}
+
+// Retrieves the invoice item category with the given ID. Returns an invoice
+// item category object and a 200 OK response code if a valid identifier was
+// provided.
+func (c *Client) GetInvoiceItemCategory(id int) (InvoiceItemCategory, error) {
+	return c.GetInvoiceItemCategoryWithContext(context.Background(), id)
+}
+
+// GetInvoiceItemCategory, bound to the provided context.
+func (c *Client) GetInvoiceItemCategoryWithContext(ctx context.Context, id int) (InvoiceItemCategory, error) {
+	ic := InvoiceItemCategory{}
+	urlTail := fmt.Sprintf("/v2/invoice_item_categories/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+type CreateInvoiceItemCategoryBody struct {
+	// The name of the invoice item category. - required
+	Name string `json:"name" url:"name,omitempty"`
+}
+
+func (b CreateInvoiceItemCategoryBody) IsValid() bool {
+	return b.Name != ""
+}
+
+// Creates a new invoice item category object. Returns an invoice item
+// category object and a 201 Created response code if the call succeeded.
+func (c *Client) CreateInvoiceItemCategory(body CreateInvoiceItemCategoryBody) (InvoiceItemCategory, error) {
+	return c.CreateInvoiceItemCategoryWithContext(context.Background(), body)
+}
+
+// CreateInvoiceItemCategory, bound to the provided context.
+func (c *Client) CreateInvoiceItemCategoryWithContext(ctx context.Context, body CreateInvoiceItemCategoryBody) (InvoiceItemCategory, error) {
+	ic := InvoiceItemCategory{}
+	if !body.IsValid() {
+		return ic, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/invoice_item_categories", body)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+type UpdateInvoiceItemCategoryBody struct {
+	// The name of the invoice item category.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+}
+
+func (b UpdateInvoiceItemCategoryBody) IsValid() bool {
+	return b.Name == nil || *b.Name != ""
+}
+
+// Updates the specific invoice item category by setting the values of the
+// parameters passed. Any parameters not provided will be left unchanged.
+// Returns an invoice item category object and a 200 OK response code if the
+// call succeeded.
+func (c *Client) UpdateInvoiceItemCategory(id int, body UpdateInvoiceItemCategoryBody) (InvoiceItemCategory, error) {
+	return c.UpdateInvoiceItemCategoryWithContext(context.Background(), id, body)
+}
+
+// UpdateInvoiceItemCategory, bound to the provided context.
+func (c *Client) UpdateInvoiceItemCategoryWithContext(ctx context.Context, id int, body UpdateInvoiceItemCategoryBody) (InvoiceItemCategory, error) {
+	ic := InvoiceItemCategory{}
+	if !body.IsValid() {
+		return ic, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/invoice_item_categories/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ic, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ic)
+	if err != nil {
+		return ic, err
+	}
+	return ic, nil
+}
+
+// Delete an invoice item category. Deleting an invoice item category is only
+// possible if use_as_service and use_as_expense are both false. Returns a
+// 200 OK response code if the call succeeded.
+func (c *Client) DeleteInvoiceItemCategory(id int) error {
+	return c.DeleteInvoiceItemCategoryWithContext(context.Background(), id)
+}
+
+// DeleteInvoiceItemCategory, bound to the provided context.
+func (c *Client) DeleteInvoiceItemCategoryWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/invoice_item_categories/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,29 @@
+package goharvest
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUpdateInvoiceItemCategoryRejectsEmptyName(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id":1,"name":"Service"}`))
+	})
+	empty := ""
+	if _, err := c.UpdateInvoiceItemCategory(1, UpdateInvoiceItemCategoryBody{Name: &empty}); err == nil {
+		t.Fatal("expected an empty name to be rejected")
+	}
+	if requests != 0 {
+		t.Fatalf("got %d requests for an invalid body, want 0", requests)
+	}
+
+	// Leaving the name unchanged is fine
+	if _, err := c.UpdateInvoiceItemCategory(1, UpdateInvoiceItemCategoryBody{}); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests, want 1", requests)
+	}
+}
@@ -0,0 +1,332 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// The event types that can be recorded on an invoice by creating an
+// invoice message. A message without an event type is sent by email.
+const (
+	// Marks a draft invoice as sent, without emailing it.
+	InvoiceEventSend = "send"
+
+	// Closes an open invoice, i.e. to write it off.
+	InvoiceEventClose = "close"
+
+	// Re-opens a closed invoice.
+	InvoiceEventReopen = "re-open"
+
+	// Returns an open invoice to draft.
+	InvoiceEventDraft = "draft"
+)
+
+// A response object from requesting invoice messages
+type InvoiceMessageResponse struct {
+	InvoiceMessages []InvoiceMessage `json:"invoice_messages"`
+	Pagination
+}
+
+func (mr InvoiceMessageResponse) Items() []InvoiceMessage {
+	return mr.InvoiceMessages
+}
+
+type InvoiceMessageRecipient struct {
+	// Name of the message recipient.
+	Name string `json:"name,omitempty" url:"name,omitempty"`
+
+	// Email of the message recipient.
+	Email string `json:"email" url:"email,omitempty"`
+}
+
+// A message sent for an invoice, or an event recorded on it
+type InvoiceMessage struct {
+	// Unique ID for the message.
+	ID int `json:"id"`
+
+	// Name of the user that created the message.
+	SentBy string `json:"sent_by"`
+
+	// Email of the user that created the message.
+	SentByEmail string `json:"sent_by_email"`
+
+	// Name of the user that the message was sent from.
+	SentFrom string `json:"sent_from"`
+
+	// Email of the user that the message was sent from.
+	SentFromEmail string `json:"sent_from_email"`
+
+	// Array of message recipients.
+	Recipients []InvoiceMessageRecipient `json:"recipients"`
+
+	// The message subject.
+	Subject string `json:"subject"`
+
+	// The message body.
+	Body string `json:"body"`
+
+	// Whether to include a link to the client invoice in the message body.
+	// Not used when thank_you is true.
+	IncludeLinkToClientInvoice bool `json:"include_link_to_client_invoice"`
+
+	// Whether to attach the invoice PDF to the message email.
+	AttachPDF bool `json:"attach_pdf"`
+
+	// Whether to email a copy of the message to the current user.
+	SendMeACopy bool `json:"send_me_a_copy"`
+
+	// Whether this is a thank you message.
+	ThankYou bool `json:"thank_you"`
+
+	// The type of invoice event that occurred with the message: send,
+	// close, draft, re-open, or view.
+	EventType string `json:"event_type"`
+
+	// Whether this is a reminder message.
+	Reminder bool `json:"reminder"`
+
+	// The date the reminder email will be sent.
+	SendReminderOn *Date `json:"send_reminder_on"`
+
+	// Date and time the message was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the message was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetInvoiceMessageParameters struct {
+	// Only return invoice messages that have been updated since the given
+	// date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of messages associated with a given invoice. The invoice
+// messages are returned sorted by creation date, with the most recently
+// created messages appearing first.
+func (c *Client) GetInvoiceMessages(invoiceID int, params GetInvoiceMessageParameters) (InvoiceMessageResponse, error) {
+	return c.GetInvoiceMessagesWithContext(context.Background(), invoiceID, params)
+}
+
+// GetInvoiceMessages, bound to the provided context.
+func (c *Client) GetInvoiceMessagesWithContext(ctx context.Context, invoiceID int, params GetInvoiceMessageParameters) (InvoiceMessageResponse, error) {
+	mr := InvoiceMessageResponse{}
+	urlTail, err := buildPathWithParams[GetInvoiceMessageParameters](fmt.Sprintf("/v2/invoices/%d/messages", invoiceID), params)
+	if err != nil {
+		return mr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return mr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&mr)
+	if err != nil {
+		return mr, err
+	}
+	return mr, nil
+}
+
+// Returns an iterator over every message associated with a given invoice,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterInvoiceMessages(ctx context.Context, invoiceID int, params GetInvoiceMessageParameters) iter.Seq2[InvoiceMessage, error] {
+	urlTail, err := buildPathWithParams[GetInvoiceMessageParameters](fmt.Sprintf("/v2/invoices/%d/messages", invoiceID), params)
+	if err != nil {
+		return errorSeq[InvoiceMessage](err)
+	}
+	return Iterate[InvoiceMessage, InvoiceMessageResponse](ctx, c, urlTail)
+}
+
+// The subject and body Harvest would use by default for messages about an
+// invoice.
+type InvoiceMessageTemplate struct {
+	// Unique ID of the associated invoice.
+	InvoiceID int `json:"invoice_id"`
+
+	// The subject for the invoice message.
+	Subject string `json:"subject"`
+
+	// The body for the invoice message.
+	Body string `json:"body"`
+
+	// The subject for a reminder message.
+	ReminderSubject string `json:"reminder_subject"`
+
+	// The body for a reminder message.
+	ReminderBody string `json:"reminder_body"`
+
+	// The subject for a thank you message.
+	ThankYouSubject string `json:"thank_you_subject"`
+
+	// The body for a thank you message.
+	ThankYouBody string `json:"thank_you_body"`
+}
+
+// Returns the subject and body text as configured in Harvest of an
+// invoice message for a specific invoice and a 200 OK response code if
+// the call succeeded. Does not create the invoice message.
+func (c *Client) GetNewInvoiceMessage(invoiceID int) (InvoiceMessageTemplate, error) {
+	return c.GetNewInvoiceMessageWithContext(context.Background(), invoiceID)
+}
+
+// GetNewInvoiceMessage, bound to the provided context.
+func (c *Client) GetNewInvoiceMessageWithContext(ctx context.Context, invoiceID int) (InvoiceMessageTemplate, error) {
+	mt := InvoiceMessageTemplate{}
+	urlTail := fmt.Sprintf("/v2/invoices/%d/messages/new", invoiceID)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return mt, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&mt)
+	if err != nil {
+		return mt, err
+	}
+	return mt, nil
+}
+
+// The body required to create an invoice message. Without an EventType,
+// the message is emailed to its Recipients, of which there must be at
+// least one, each with an email address. With an EventType, the event is
+// recorded on the invoice and nothing is emailed; see
+// MarkInvoiceAsSent, CloseInvoice, ReopenInvoice, and MarkInvoiceAsDraft.
+type CreateInvoiceMessageBody struct {
+	// One of send, close, draft, or re-open. Leave empty to email the
+	// message. - optional
+	EventType string `json:"event_type,omitempty" url:"event_type,omitempty"`
+
+	// Array of recipient parameters. - required when emailing
+	Recipients []InvoiceMessageRecipient `json:"recipients,omitempty" url:"recipients,omitempty"`
+
+	// The message subject. - optional
+	Subject string `json:"subject,omitempty" url:"subject,omitempty"`
+
+	// The message body. - optional
+	Body string `json:"body,omitempty" url:"body,omitempty"`
+
+	// If set to true, a link to the client invoice URL will be automatically
+	// inserted below the message body. Defaults to false. - optional
+	IncludeLinkToClientInvoice bool `json:"include_link_to_client_invoice,omitempty" url:"include_link_to_client_invoice,omitempty"`
+
+	// If set to true, a PDF of the invoice will be attached to the message.
+	// Defaults to false. - optional
+	AttachPDF bool `json:"attach_pdf,omitempty" url:"attach_pdf,omitempty"`
+
+	// If set to true, a copy of the message email will be sent to the
+	// current user. Defaults to false. - optional
+	SendMeACopy bool `json:"send_me_a_copy,omitempty" url:"send_me_a_copy,omitempty"`
+
+	// If set to true, a thank you message email will be sent. Defaults to
+	// false. - optional
+	ThankYou bool `json:"thank_you,omitempty" url:"thank_you,omitempty"`
+}
+
+func (b CreateInvoiceMessageBody) IsValid() bool {
+	switch b.EventType {
+	case InvoiceEventSend, InvoiceEventClose, InvoiceEventReopen, InvoiceEventDraft:
+		return true
+	case "":
+	default:
+		return false
+	}
+	if len(b.Recipients) == 0 {
+		return false
+	}
+	for _, r := range b.Recipients {
+		if r.Email == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Creates a new invoice message object. Returns an invoice message object
+// and a 201 Created response code if the call succeeded.
+func (c *Client) CreateInvoiceMessage(invoiceID int, body CreateInvoiceMessageBody) (InvoiceMessage, error) {
+	return c.CreateInvoiceMessageWithContext(context.Background(), invoiceID, body)
+}
+
+// CreateInvoiceMessage, bound to the provided context.
+func (c *Client) CreateInvoiceMessageWithContext(ctx context.Context, invoiceID int, body CreateInvoiceMessageBody) (InvoiceMessage, error) {
+	im := InvoiceMessage{}
+	if !body.IsValid() {
+		return im, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/invoices/%d/messages", invoiceID)
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return im, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&im)
+	if err != nil {
+		return im, err
+	}
+	return im, nil
+}
+
+// Marks a draft invoice as sent, without emailing it. Returns the
+// recorded invoice message.
+func (c *Client) MarkInvoiceAsSent(invoiceID int) (InvoiceMessage, error) {
+	return c.MarkInvoiceAsSentWithContext(context.Background(), invoiceID)
+}
+
+// MarkInvoiceAsSent, bound to the provided context.
+func (c *Client) MarkInvoiceAsSentWithContext(ctx context.Context, invoiceID int) (InvoiceMessage, error) {
+	return c.CreateInvoiceMessageWithContext(ctx, invoiceID, CreateInvoiceMessageBody{EventType: InvoiceEventSend})
+}
+
+// Marks an open invoice as closed. Returns the recorded invoice message.
+func (c *Client) CloseInvoice(invoiceID int) (InvoiceMessage, error) {
+	return c.CloseInvoiceWithContext(context.Background(), invoiceID)
+}
+
+// CloseInvoice, bound to the provided context.
+func (c *Client) CloseInvoiceWithContext(ctx context.Context, invoiceID int) (InvoiceMessage, error) {
+	return c.CreateInvoiceMessageWithContext(ctx, invoiceID, CreateInvoiceMessageBody{EventType: InvoiceEventClose})
+}
+
+// Re-opens a closed invoice. Returns the recorded invoice message.
+func (c *Client) ReopenInvoice(invoiceID int) (InvoiceMessage, error) {
+	return c.ReopenInvoiceWithContext(context.Background(), invoiceID)
+}
+
+// ReopenInvoice, bound to the provided context.
+func (c *Client) ReopenInvoiceWithContext(ctx context.Context, invoiceID int) (InvoiceMessage, error) {
+	return c.CreateInvoiceMessageWithContext(ctx, invoiceID, CreateInvoiceMessageBody{EventType: InvoiceEventReopen})
+}
+
+// Marks an open invoice as a draft. Returns the recorded invoice message.
+func (c *Client) MarkInvoiceAsDraft(invoiceID int) (InvoiceMessage, error) {
+	return c.MarkInvoiceAsDraftWithContext(context.Background(), invoiceID)
+}
+
+// MarkInvoiceAsDraft, bound to the provided context.
+func (c *Client) MarkInvoiceAsDraftWithContext(ctx context.Context, invoiceID int) (InvoiceMessage, error) {
+	return c.CreateInvoiceMessageWithContext(ctx, invoiceID, CreateInvoiceMessageBody{EventType: InvoiceEventDraft})
+}
+
+// Delete an invoice message. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteInvoiceMessage(invoiceID int, messageID int) error {
+	return c.DeleteInvoiceMessageWithContext(context.Background(), invoiceID, messageID)
+}
+
+// DeleteInvoiceMessage, bound to the provided context.
+func (c *Client) DeleteInvoiceMessageWithContext(ctx context.Context, invoiceID int, messageID int) error {
+	urlTail := fmt.Sprintf("/v2/invoices/%d/messages/%d", invoiceID, messageID)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,26 @@
+package goharvest
+
+import "testing"
+
+func TestCreateInvoiceMessageBodyIsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		body CreateInvoiceMessageBody
+		want bool
+	}{
+		{"email", CreateInvoiceMessageBody{Recipients: []InvoiceMessageRecipient{{Email: "a@example.com"}}}, true},
+		{"email without recipients", CreateInvoiceMessageBody{}, false},
+		{"recipient without email", CreateInvoiceMessageBody{Recipients: []InvoiceMessageRecipient{{Name: "A"}}}, false},
+		{"send", CreateInvoiceMessageBody{EventType: InvoiceEventSend}, true},
+		{"close", CreateInvoiceMessageBody{EventType: InvoiceEventClose}, true},
+		{"re-open", CreateInvoiceMessageBody{EventType: InvoiceEventReopen}, true},
+		{"draft", CreateInvoiceMessageBody{EventType: InvoiceEventDraft}, true},
+		{"misspelled event", CreateInvoiceMessageBody{EventType: "reopen"}, false},
+		{"unknown event with recipients", CreateInvoiceMessageBody{EventType: "view", Recipients: []InvoiceMessageRecipient{{Email: "a@example.com"}}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.body.IsValid(); got != tt.want {
+			t.Errorf("%s: IsValid() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
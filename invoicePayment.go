@@ -0,0 +1,175 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting invoice payments
+type InvoicePaymentResponse struct {
+	InvoicePayments []InvoicePayment `json:"invoice_payments"`
+	Pagination
+}
+
+func (pr InvoicePaymentResponse) Items() []InvoicePayment {
+	return pr.InvoicePayments
+}
+
+type InvoicePaymentGateway struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// A payment recorded against an invoice
+type InvoicePayment struct {
+	// Unique ID for the payment.
+	ID int `json:"id"`
+
+	// The amount of the payment.
+	Amount float64 `json:"amount"`
+
+	// Date and time the payment was made.
+	PaidAt *time.Time `json:"paid_at"`
+
+	// Date the payment was made.
+	PaidDate *Date `json:"paid_date"`
+
+	// The name of the person who recorded the payment.
+	RecordedBy string `json:"recorded_by"`
+
+	// The email of the person who recorded the payment.
+	RecordedByEmail string `json:"recorded_by_email"`
+
+	// Any notes associated with the payment.
+	Notes string `json:"notes"`
+
+	// Either the card authorization or PayPal transaction ID.
+	TransactionID string `json:"transaction_id"`
+
+	// The payment gateway id and name used to process the payment.
+	PaymentGateway *InvoicePaymentGateway `json:"payment_gateway"`
+
+	// Date and time the payment was recorded.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the payment was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetInvoicePaymentParameters struct {
+	// Only return invoice payments that have been updated since the given
+	// date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of payments associated with a given invoice. The payments
+// are returned sorted by creation date, with the most recently created
+// payments appearing first.
+func (c *Client) GetInvoicePayments(invoiceID int, params GetInvoicePaymentParameters) (InvoicePaymentResponse, error) {
+	return c.GetInvoicePaymentsWithContext(context.Background(), invoiceID, params)
+}
+
+// GetInvoicePayments, bound to the provided context.
+func (c *Client) GetInvoicePaymentsWithContext(ctx context.Context, invoiceID int, params GetInvoicePaymentParameters) (InvoicePaymentResponse, error) {
+	pr := InvoicePaymentResponse{}
+	urlTail, err := buildPathWithParams[GetInvoicePaymentParameters](fmt.Sprintf("/v2/invoices/%d/payments", invoiceID), params)
+	if err != nil {
+		return pr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return pr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&pr)
+	if err != nil {
+		return pr, err
+	}
+	return pr, nil
+}
+
+// Returns an iterator over every payment associated with a given invoice,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterInvoicePayments(ctx context.Context, invoiceID int, params GetInvoicePaymentParameters) iter.Seq2[InvoicePayment, error] {
+	urlTail, err := buildPathWithParams[GetInvoicePaymentParameters](fmt.Sprintf("/v2/invoices/%d/payments", invoiceID), params)
+	if err != nil {
+		return errorSeq[InvoicePayment](err)
+	}
+	return Iterate[InvoicePayment, InvoicePaymentResponse](ctx, c, urlTail)
+}
+
+type CreateInvoicePaymentBody struct {
+	// The amount of the payment. Must be greater than zero. - required
+	Amount float64 `json:"amount" url:"amount,omitempty"`
+
+	// Date and time the payment was made. Pass either PaidAt or PaidDate,
+	// but not both. - optional
+	PaidAt *time.Time `json:"paid_at,omitempty" url:"paid_at,omitempty"`
+
+	// Date the payment was made. Pass either PaidAt or PaidDate, but not
+	// both. - optional
+	PaidDate *Date `json:"paid_date,omitempty" url:"paid_date,omitempty"`
+
+	// Any notes to be associated with the payment. - optional
+	Notes string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// Whether or not to send a thank you email (if enabled for your account
+	// in Invoices > Configure > Messages). Only sends an email if the
+	// invoice will be fully paid after creating this payment. Defaults to
+	// true. - optional
+	SendThankYou *bool `json:"send_thank_you,omitempty" url:"send_thank_you,omitempty"`
+}
+
+func (b CreateInvoicePaymentBody) IsValid() bool {
+	return b.Amount > 0 && !(b.PaidAt != nil && b.PaidDate != nil)
+}
+
+// Creates a new invoice payment object. Returns an invoice payment object
+// and a 201 Created response code if the call succeeded.
+func (c *Client) CreateInvoicePayment(invoiceID int, body CreateInvoicePaymentBody) (InvoicePayment, error) {
+	return c.CreateInvoicePaymentWithContext(context.Background(), invoiceID, body)
+}
+
+// CreateInvoicePayment, bound to the provided context.
+func (c *Client) CreateInvoicePaymentWithContext(ctx context.Context, invoiceID int, body CreateInvoicePaymentBody) (InvoicePayment, error) {
+	ip := InvoicePayment{}
+	if !body.IsValid() {
+		return ip, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/invoices/%d/payments", invoiceID)
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ip, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ip)
+	if err != nil {
+		return ip, err
+	}
+	return ip, nil
+}
+
+// Delete an invoice payment. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteInvoicePayment(invoiceID int, paymentID int) error {
+	return c.DeleteInvoicePaymentWithContext(context.Background(), invoiceID, paymentID)
+}
+
+// DeleteInvoicePayment, bound to the provided context.
+func (c *Client) DeleteInvoicePaymentWithContext(ctx context.Context, invoiceID int, paymentID int) error {
+	urlTail := fmt.Sprintf("/v2/invoices/%d/payments/%d", invoiceID, paymentID)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -0,0 +1,37 @@
+package goharvest
+
+import "testing"
+
+func TestCreateInvoiceBodyIsValid(t *testing.T) {
+	price := 100.0
+	zero := 0.0
+	tests := []struct {
+		name string
+		body CreateInvoiceBody
+		want bool
+	}{
+		{"client only", CreateInvoiceBody{ClientID: 1}, true},
+		{"missing client", CreateInvoiceBody{}, false},
+		{"free-form", CreateInvoiceBody{ClientID: 1, LineItems: []CreateInvoiceLineItem{{Kind: "Service", UnitPrice: &price}}}, true},
+		{"free-form at no charge", CreateInvoiceBody{ClientID: 1, LineItems: []CreateInvoiceLineItem{{Kind: "Service", UnitPrice: &zero}}}, true},
+		{"line item without kind", CreateInvoiceBody{ClientID: 1, LineItems: []CreateInvoiceLineItem{{UnitPrice: &price}}}, false},
+		{"line item without unit price", CreateInvoiceBody{ClientID: 1, LineItems: []CreateInvoiceLineItem{{Kind: "Service"}}}, false},
+		{"import", CreateInvoiceBody{ClientID: 1, LineItemsImport: &InvoiceLineItemsImport{ProjectIDs: []int{2}}}, true},
+		{"import without projects", CreateInvoiceBody{ClientID: 1, LineItemsImport: &InvoiceLineItemsImport{}}, false},
+		{"import without time summary type", CreateInvoiceBody{ClientID: 1, LineItemsImport: &InvoiceLineItemsImport{ProjectIDs: []int{2}, Time: &InvoiceTimeImport{}}}, false},
+		{
+			"free-form and import",
+			CreateInvoiceBody{
+				ClientID:        1,
+				LineItems:       []CreateInvoiceLineItem{{Kind: "Service", UnitPrice: &price}},
+				LineItemsImport: &InvoiceLineItemsImport{ProjectIDs: []int{2}},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.body.IsValid(); got != tt.want {
+			t.Errorf("%s: IsValid() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package goharvest
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The most bytes of a request or response body included in a debug log.
+const maxLoggedBodyBytes = 2048
+
+// Response headers that describe rate limiting, logged when present.
+var rateLimitHeaders = []string{
+	"Retry-After",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+}
+
+// Returns the built-in Middleware that logs every request to the logger:
+// its method, path, query, status, latency, retry attempt, and any rate
+// limit headers on the response. Failed requests are logged at Warn, and
+// transport errors at Error. When the logger has Debug enabled, the
+// request headers, with Authorization redacted, and the first 2KB of the
+// request and response bodies are logged as well. Every Client with a
+// Logger includes it, configured from Client.Logger.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		if logger == nil {
+			return next
+		}
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("query", req.URL.RawQuery),
+				slog.Int("attempt", AttemptFromContext(ctx)),
+			}
+			if debug {
+				attrs = append(attrs, slog.Any("request_headers", redactHeaders(req.Header)))
+				if body := peekRequestBody(req); body != "" {
+					attrs = append(attrs, slog.String("request_body", body))
+				}
+			}
+
+			start := time.Now()
+			res, err := next(req)
+			attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelError, "harvest request failed", attrs...)
+				return res, err
+			}
+
+			attrs = append(attrs, slog.Int("status", res.StatusCode))
+			for _, header := range rateLimitHeaders {
+				if value := res.Header.Get(header); value != "" {
+					attrs = append(attrs, slog.String(strings.ToLower(header), value))
+				}
+			}
+			if debug {
+				attrs = append(attrs, slog.String("response_body", peekResponseBody(res)))
+			}
+			level := slog.LevelInfo
+			if res.StatusCode >= 400 {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "harvest request", attrs...)
+			return res, nil
+		}
+	}
+}
+
+// Returns a copy of the headers safe to log, with credentials redacted.
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	if redacted.Get("Authorization") != "" {
+		redacted.Set("Authorization", "REDACTED")
+	}
+	return redacted
+}
+
+// Returns the start of a request's body without consuming it.
+func peekRequestBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	return readTruncated(body)
+}
+
+// Returns the start of a response's body, leaving the full body in place
+// for the caller to read.
+func peekResponseBody(res *http.Response) string {
+	prefix := make([]byte, maxLoggedBodyBytes+1)
+	n, _ := io.ReadFull(res.Body, prefix)
+	prefix = prefix[:n]
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), res.Body), res.Body}
+	return truncate(prefix)
+}
+
+func readTruncated(r io.Reader) string {
+	ba, _ := io.ReadAll(io.LimitReader(r, maxLoggedBodyBytes+1))
+	return truncate(ba)
+}
+
+func truncate(ba []byte) string {
+	if len(ba) > maxLoggedBodyBytes {
+		return string(ba[:maxLoggedBodyBytes]) + "...(truncated)"
+	}
+	return string(ba)
+}
@@ -0,0 +1,66 @@
+package goharvest
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggingMiddlewareDebug(t *testing.T) {
+	big := strings.Repeat("x", 3*maxLoggedBodyBytes)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Write([]byte(big))
+	})
+	c.TokenSource = StaticTokenSource("secret-token")
+	var buf bytes.Buffer
+	c.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	res, err := c.Post("/v2/clients", map[string]string{"name": big})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Logging the body must not consume it
+	if string(body) != big {
+		t.Fatalf("got a %d byte response body, want %d bytes", len(body), len(big))
+	}
+
+	logged := buf.String()
+	if strings.Contains(logged, "secret-token") {
+		t.Fatalf("access token was logged: %s", logged)
+	}
+	for _, want := range []string{"Authorization:[REDACTED]", "status=200", "attempt=1", "x-ratelimit-remaining=99", "...(truncated)"} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log is missing %q: %s", want, logged)
+		}
+	}
+	if strings.Contains(logged, strings.Repeat("x", maxLoggedBodyBytes+1)) {
+		t.Errorf("a body over %d bytes was logged in full", maxLoggedBodyBytes)
+	}
+}
+
+func TestLoggingMiddlewareInfoOmitsBodies(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not_found"}`))
+	})
+	var buf bytes.Buffer
+	c.Logger = slog.New(slog.NewTextHandler(&buf, nil))
+
+	c.Get("/v2/clients/1")
+	logged := buf.String()
+	if !strings.Contains(logged, "level=WARN") || !strings.Contains(logged, "status=404") {
+		t.Fatalf("expected a warning with the status: %s", logged)
+	}
+	if strings.Contains(logged, "not_found") || strings.Contains(logged, "Authorization") {
+		t.Fatalf("bodies and headers logged below Debug: %s", logged)
+	}
+}
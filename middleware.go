@@ -0,0 +1,88 @@
+package goharvest
+
+import (
+	"net/http"
+)
+
+// Sends a request and returns its response, in the manner of
+// http.Client.Do.
+type Handler func(*http.Request) (*http.Response, error)
+
+// Wraps a Handler with additional behavior, such as logging, metrics,
+// header injection, request signing, or fault injection. A Middleware may
+// modify the request before calling next, inspect or replace the response
+// after, or skip next entirely.
+//
+// Middlewares that modify the request should do so on a clone, since the
+// retry middleware replays the original request on each attempt.
+type Middleware func(next Handler) Handler
+
+// Returns a Middleware that calls fn with each outgoing request before it
+// is sent. If fn returns an error, the request is not sent and the error
+// is returned instead.
+func RequestInterceptor(fn func(*http.Request) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// Returns a Middleware that calls fn with each response as soon as it is
+// received. If fn returns an error, the response body is closed and the
+// error is returned instead.
+func ResponseInterceptor(fn func(*http.Response) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			res, err := next(req)
+			if err != nil {
+				return res, err
+			}
+			if err := fn(res); err != nil {
+				res.Body.Close()
+				return nil, err
+			}
+			return res, nil
+		}
+	}
+}
+
+// Adds middlewares to the client. Middlewares added first are outermost,
+// so they see each request first and each response last. All of them run
+// inside the built-in retry, rate-limit, and auth middlewares, so they are
+// called once per attempt and see the request as it goes over the wire.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+}
+
+// Builds the full chain for a request: the built-in retry, rate-limit,
+// and auth middlewares, then the client's own Middlewares, then the
+// logging middleware, and finally the underlying HTTP Client.
+func (c *Client) handler() Handler {
+	h := Handler(c.Client.Do)
+	h = LoggingMiddleware(c.Logger)(h)
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		h = c.Middlewares[i](h)
+	}
+	h = AuthMiddleware(c.TokenSource)(h)
+	h = RateLimitMiddleware(c.Limiter, c.ReportsLimiter)(h)
+	h = RetryMiddleware(c.Retry)(h)
+	return h
+}
+
+// Returns a fresh copy of req, with its body rewound, so that it can be
+// sent again.
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
@@ -0,0 +1,89 @@
+package goharvest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// A file to upload alongside a request, such as an expense receipt. The
+// Content is read in full when the request is built, so that the request
+// can be replayed if it is retried.
+type Receipt struct {
+	// The name of the file, i.e. "receipt.pdf". Its extension determines
+	// the content type the file is sent with.
+	Filename string
+
+	// The contents of the file.
+	Content io.Reader
+}
+
+// A pre-encoded multipart/form-data request body. newRequest sends it
+// as-is rather than encoding it as JSON.
+type multipartBody struct {
+	contentType string
+	data        []byte
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// Encodes the url-tagged fields of the provided body as form fields,
+// followed by the file as a part with the provided field name.
+func newMultipartBody(body any, fileField string, file Receipt) (multipartBody, error) {
+	mb := multipartBody{}
+	if file.Content == nil {
+		return mb, errors.New("Receipt has no content")
+	}
+	values, err := query.Values(body)
+	if err != nil {
+		return mb, err
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, k := range keys {
+		for _, v := range values[k] {
+			if err := w.WriteField(k, v); err != nil {
+				return mb, err
+			}
+		}
+	}
+
+	filename := filepath.Base(file.Filename)
+	contentType := mime.TypeByExtension(filepath.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		quoteEscaper.Replace(fileField), quoteEscaper.Replace(filename)))
+	h.Set("Content-Type", contentType)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return mb, err
+	}
+	if _, err := io.Copy(part, file.Content); err != nil {
+		return mb, err
+	}
+	if err := w.Close(); err != nil {
+		return mb, err
+	}
+
+	mb.contentType = w.FormDataContentType()
+	mb.data = buf.Bytes()
+	return mb, nil
+}
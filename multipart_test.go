@@ -0,0 +1,179 @@
+package goharvest
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The parts of a multipart/form-data request, as received by the server.
+type receivedForm struct {
+	fields          map[string][]string
+	fileField       string
+	filename        string
+	fileContentType string
+	file            []byte
+}
+
+func readMultipartRequest(t *testing.T, r *http.Request) receivedForm {
+	t.Helper()
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Errorf("got Content-Type %q, want multipart/form-data", r.Header.Get("Content-Type"))
+		return receivedForm{}
+	}
+	form := receivedForm{fields: map[string][]string{}}
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Errorf("reading part: %v", err)
+			break
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Errorf("reading part: %v", err)
+		}
+		if part.FileName() != "" {
+			form.fileField = part.FormName()
+			form.filename = part.FileName()
+			form.fileContentType = part.Header.Get("Content-Type")
+			form.file = content
+			continue
+		}
+		form.fields[part.FormName()] = append(form.fields[part.FormName()], string(content))
+	}
+	return form
+}
+
+func TestCreateExpenseWithReceipt(t *testing.T) {
+	pdf := []byte("%PDF-1.4 not really a receipt")
+	var forms []receivedForm
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		forms = append(forms, readMultipartRequest(t, r))
+		// Throttle the first attempt, so the upload is retried
+		if len(forms) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	units := 2.5
+	billable := false
+	ex, err := c.CreateExpense(CreateExpenseBody{
+		ProjectID:         10,
+		ExpenseCategoryID: 20,
+		SpentDate:         Date{time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+		Units:             &units,
+		Notes:             "Taxi",
+		Billable:          &billable,
+		Receipt:           &Receipt{Filename: "/tmp/receipts/taxi.pdf", Content: bytes.NewReader(pdf)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ex.ID != 1 {
+		t.Fatalf("got expense %d, want 1", ex.ID)
+	}
+	if len(forms) != 2 {
+		t.Fatalf("got %d requests, want 2", len(forms))
+	}
+	for i, form := range forms {
+		want := map[string]string{
+			"project_id":          "10",
+			"expense_category_id": "20",
+			"spent_date":          "2024-03-05",
+			"units":               "2.5",
+			"notes":               "Taxi",
+			"billable":            "false",
+		}
+		if len(form.fields) != len(want) {
+			t.Errorf("attempt %d: got fields %v, want %v", i+1, form.fields, want)
+		}
+		for k, v := range want {
+			if got := form.fields[k]; len(got) != 1 || got[0] != v {
+				t.Errorf("attempt %d: field %s = %q, want %q", i+1, k, got, v)
+			}
+		}
+		if form.fileField != "receipt" || form.filename != "taxi.pdf" || form.fileContentType != "application/pdf" {
+			t.Errorf("attempt %d: got file part %q named %q of type %q", i+1, form.fileField, form.filename, form.fileContentType)
+		}
+		if !bytes.Equal(form.file, pdf) {
+			t.Errorf("attempt %d: got file %q, want %q", i+1, form.file, pdf)
+		}
+	}
+}
+
+func TestCreateExpenseWithoutReceiptSendsJSON(t *testing.T) {
+	var contentType, body string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		ba, _ := io.ReadAll(r.Body)
+		body = string(ba)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	_, err := c.CreateExpense(CreateExpenseBody{
+		ProjectID:         10,
+		ExpenseCategoryID: 20,
+		SpentDate:         Date{time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "application/json" || !strings.Contains(body, `"spent_date":"2024-03-05"`) {
+		t.Fatalf("got %q body %s, want JSON", contentType, body)
+	}
+}
+
+func TestUploadExpenseReceiptUnknownExtension(t *testing.T) {
+	var form receivedForm
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		form = readMultipartRequest(t, r)
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	if _, err := c.UploadExpenseReceipt(1, Receipt{Filename: "receipt", Content: strings.NewReader("data")}); err != nil {
+		t.Fatal(err)
+	}
+	if len(form.fields) != 0 || form.filename != "receipt" || form.fileContentType != "application/octet-stream" || string(form.file) != "data" {
+		t.Fatalf("unexpected form %+v", form)
+	}
+}
+
+func TestUpdateExpenseRejectsReceiptWithDeleteReceipt(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	deleteReceipt := true
+	_, err := c.UpdateExpense(1, UpdateExpenseBody{
+		Receipt:       &Receipt{Filename: "taxi.pdf", Content: strings.NewReader("data")},
+		DeleteReceipt: &deleteReceipt,
+	})
+	if err == nil {
+		t.Fatal("expected a receipt combined with DeleteReceipt to be rejected")
+	}
+	if requests != 0 {
+		t.Fatalf("got %d requests for an invalid body, want 0", requests)
+	}
+}
+
+func TestNewMultipartBodyRequiresContent(t *testing.T) {
+	if _, err := newMultipartBody(UpdateExpenseBody{}, "receipt", Receipt{Filename: "taxi.pdf"}); err == nil {
+		t.Fatal("expected a receipt without content to be rejected")
+	}
+}
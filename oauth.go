@@ -0,0 +1,390 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The Harvest ID endpoints used by the OAuth2 authorization code flow.
+const (
+	DefaultOAuthAuthURL     = "https://id.getharvest.com/oauth2/authorize"
+	DefaultOAuthTokenURL    = "https://id.getharvest.com/api/v2/oauth2/token"
+	DefaultOAuthAccountsURL = "https://id.getharvest.com/api/v2/accounts"
+)
+
+// How long before its expiry a token is treated as expired, so that it
+// isn't rejected while a request is in flight.
+const tokenExpiryDelta = time.Minute
+
+// An access token, either a Personal Access Token or one obtained through
+// the OAuth2 flow.
+type Token struct {
+	// The token sent in the Authorization header of each request.
+	AccessToken string `json:"access_token"`
+
+	// The token used to obtain a new AccessToken once it expires. Empty for
+	// Personal Access Tokens.
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	// The type of the token. Harvest always issues "bearer" tokens.
+	TokenType string `json:"token_type,omitempty"`
+
+	// When the AccessToken expires. The zero value means it never expires.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Whether the token is present and not about to expire.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// Supplies the token used to authenticate each request. Implementations
+// must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// A TokenSource that can obtain a new token when Harvest rejects the
+// current one with a 401. The Client calls Refresh with the access token
+// that was rejected; if the source has already moved past that token, it
+// should return its current token instead of refreshing again.
+type RefreshableTokenSource interface {
+	TokenSource
+	Refresh(ctx context.Context, rejected string) (*Token, error)
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// Returns a TokenSource that always supplies the given token, such as a
+// Personal Access Token.
+func StaticTokenSource(accessToken string) TokenSource {
+	return staticTokenSource{&Token{AccessToken: accessToken, TokenType: "bearer"}}
+}
+
+// Returns the built-in Middleware that sets the Authorization header from
+// the TokenSource. If Harvest rejects the token with a 401 and the source
+// is a RefreshableTokenSource, the token is refreshed and the request is
+// sent once more. Every Client includes it, configured from
+// Client.TokenSource.
+func AuthMiddleware(ts TokenSource) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if ts == nil {
+				return nil, errors.New("goharvest: Client has no TokenSource")
+			}
+			ctx := req.Context()
+			token, err := ts.Token(ctx)
+			if err != nil {
+				return nil, err
+			}
+			authReq := req.Clone(ctx)
+			authReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+			res, err := next(authReq)
+			if err != nil || res.StatusCode != http.StatusUnauthorized {
+				return res, err
+			}
+
+			rts, ok := ts.(RefreshableTokenSource)
+			if !ok {
+				return res, nil
+			}
+			drainBody(res)
+			token, err = rts.Refresh(ctx, token.AccessToken)
+			if err != nil {
+				return nil, err
+			}
+			authReq, err = cloneRequest(req)
+			if err != nil {
+				return nil, err
+			}
+			authReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+			return next(authReq)
+		}
+	}
+}
+
+// The settings for an OAuth2 application registered with Harvest ID. The
+// URL fields default to Harvest's endpoints when left empty, and can be
+// pointed at a local stand-in server for testing.
+type OAuthConfig struct {
+	// The application's client ID, from the Harvest developer tools.
+	ClientID string
+
+	// The application's client secret, from the Harvest developer tools.
+	ClientSecret string
+
+	// The URL users are sent to in order to authorize the application.
+	AuthURL string
+
+	// The URL used to exchange codes and refresh tokens for access tokens.
+	TokenURL string
+
+	// The URL listing the accounts the authorizing user can access.
+	AccountsURL string
+
+	// The User-Agent to send to Harvest ID. See Client.UserAgent.
+	UserAgent string
+
+	// The HTTP Client used to reach Harvest ID. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func (o *OAuthConfig) authURL() string {
+	if o.AuthURL != "" {
+		return o.AuthURL
+	}
+	return DefaultOAuthAuthURL
+}
+
+func (o *OAuthConfig) tokenURL() string {
+	if o.TokenURL != "" {
+		return o.TokenURL
+	}
+	return DefaultOAuthTokenURL
+}
+
+func (o *OAuthConfig) accountsURL() string {
+	if o.AccountsURL != "" {
+		return o.AccountsURL
+	}
+	return DefaultOAuthAccountsURL
+}
+
+func (o *OAuthConfig) httpClient() *http.Client {
+	if o.HTTPClient != nil {
+		return o.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// Returns the URL to send a user to in order to authorize the
+// application. Harvest redirects back to the application's configured
+// redirect URL with `code` and the provided `state` as query parameters.
+func (o *OAuthConfig) AuthCodeURL(state string) string {
+	params := url.Values{}
+	params.Set("client_id", o.ClientID)
+	params.Set("response_type", "code")
+	if state != "" {
+		params.Set("state", state)
+	}
+	sep := "?"
+	if strings.Contains(o.authURL(), "?") {
+		sep = "&"
+	}
+	return o.authURL() + sep + params.Encode()
+}
+
+// Exchanges the code Harvest returned to the redirect URL for a token.
+func (o *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	return o.retrieveToken(ctx, url.Values{
+		"code":       {code},
+		"grant_type": {"authorization_code"},
+	})
+}
+
+// Uses a refresh token to obtain a new token.
+func (o *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("goharvest: no refresh token")
+	}
+	return o.retrieveToken(ctx, url.Values{
+		"refresh_token": {refreshToken},
+		"grant_type":    {"refresh_token"},
+	})
+}
+
+// Posts to the token endpoint with the given grant and decodes the token
+// from the response.
+func (o *OAuthConfig) retrieveToken(ctx context.Context, form url.Values) (*Token, error) {
+	form.Set("client_id", o.ClientID)
+	form.Set("client_secret", o.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, "POST", o.tokenURL(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", o.UserAgent)
+	res, err := o.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	ba, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, newAPIError("POST", o.tokenURL(), res.StatusCode, ba)
+	}
+
+	tr := struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int    `json:"expires_in"`
+	}{}
+	err = json.Unmarshal(ba, &tr)
+	if err != nil {
+		return nil, err
+	}
+	if tr.AccessToken == "" {
+		return nil, errors.New("goharvest: token response has no access_token")
+	}
+	token := &Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tr.TokenType,
+	}
+	if tr.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// A RefreshableTokenSource that refreshes its token through an OAuthConfig
+// whenever the token expires or Harvest rejects it.
+type OAuthTokenSource struct {
+	// Called with each newly refreshed token, i.e. to persist it. Called
+	// while the source is locked, so it must not use the source.
+	OnRefresh func(*Token)
+
+	config *OAuthConfig
+	mu     sync.Mutex
+	token  *Token
+}
+
+// Returns a TokenSource that starts from the given token and refreshes it
+// as needed.
+func (o *OAuthConfig) TokenSource(token *Token) *OAuthTokenSource {
+	return &OAuthTokenSource{config: o, token: token}
+}
+
+// Returns the current token, refreshing it first if it has expired.
+func (s *OAuthTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// Refreshes the token, unless it has already been refreshed since the
+// rejected access token was handed out.
+func (s *OAuthTokenSource) Refresh(ctx context.Context, rejected string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && s.token.AccessToken != rejected && s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// The caller must hold the lock.
+func (s *OAuthTokenSource) refresh(ctx context.Context) (*Token, error) {
+	if s.token == nil {
+		return nil, errors.New("goharvest: no token to refresh")
+	}
+	token, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// Harvest may not issue a new refresh token with every refresh
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	s.token = token
+	if s.OnRefresh != nil {
+		s.OnRefresh(token)
+	}
+	return token, nil
+}
+
+// The user who authorized the application, as reported by Harvest ID.
+type AccountsUser struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+// An account the authorizing user can access.
+type Account struct {
+	// The account ID, used as the Client's AccountID.
+	ID int `json:"id"`
+
+	// The name of the account.
+	Name string `json:"name"`
+
+	// The product the account belongs to. Either "harvest" or "forecast".
+	Product string `json:"product"`
+}
+
+// A response object from requesting the authorizing user's accounts.
+type AccountsResponse struct {
+	User     AccountsUser `json:"user"`
+	Accounts []Account    `json:"accounts"`
+}
+
+// Returns only the accounts for the Harvest product, which are the ones a
+// Client can make requests against.
+func (a AccountsResponse) HarvestAccounts() []Account {
+	accounts := []Account{}
+	for _, account := range a.Accounts {
+		if account.Product == "harvest" {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+// Retrieves the user who owns the token and the accounts they can access,
+// so that an AccountID can be chosen after authorization.
+func (o *OAuthConfig) Accounts(ctx context.Context, ts TokenSource) (AccountsResponse, error) {
+	ar := AccountsResponse{}
+	token, err := ts.Token(ctx)
+	if err != nil {
+		return ar, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", o.accountsURL(), nil)
+	if err != nil {
+		return ar, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("User-Agent", o.UserAgent)
+	res, err := o.httpClient().Do(req)
+	if err != nil {
+		return ar, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		ba, err := io.ReadAll(res.Body)
+		if err != nil {
+			return ar, err
+		}
+		return ar, newAPIError("GET", o.accountsURL(), res.StatusCode, ba)
+	}
+	err = json.NewDecoder(res.Body).Decode(&ar)
+	if err != nil {
+		return ar, err
+	}
+	return ar, nil
+}
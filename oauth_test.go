@@ -0,0 +1,281 @@
+package goharvest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A stand-in for Harvest ID's token and accounts endpoints. Each grant
+// issues the next access token in sequence: "access-1", "access-2", and
+// so on.
+type fakeHarvestID struct {
+	t         *testing.T
+	server    *httptest.Server
+	mu        sync.Mutex
+	issued    int
+	refreshes int
+	forms     []url.Values
+	// Whether refresh grants include a new refresh token
+	rotateRefreshToken bool
+}
+
+func newFakeHarvestID(t *testing.T) *fakeHarvestID {
+	f := &fakeHarvestID{t: t, rotateRefreshToken: true}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/oauth2/token", f.token)
+	mux.HandleFunc("/api/v2/accounts", f.accounts)
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeHarvestID) config() *OAuthConfig {
+	return &OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		AuthURL:      f.server.URL + "/oauth2/authorize",
+		TokenURL:     f.server.URL + "/api/v2/oauth2/token",
+		AccountsURL:  f.server.URL + "/api/v2/accounts",
+		UserAgent:    "go-harvest tests",
+	}
+}
+
+func (f *fakeHarvestID) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		f.t.Errorf("parsing token form: %v", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forms = append(f.forms, r.PostForm)
+	if r.PostForm.Get("client_id") != "client-id" || r.PostForm.Get("client_secret") != "client-secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+		return
+	}
+	refreshToken := ""
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		if r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		refreshToken = "refresh-0"
+	case "refresh_token":
+		f.refreshes++
+		if f.rotateRefreshToken {
+			refreshToken = fmt.Sprintf("refresh-%d", f.refreshes)
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.issued++
+	fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":%q,"token_type":"bearer","expires_in":1209600}`, f.issued, refreshToken)
+}
+
+func (f *fakeHarvestID) accounts(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer access-1" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.Write([]byte(`{
+		"user": {"id": 1, "first_name": "Ada", "last_name": "Lovelace", "email": "ada@example.com"},
+		"accounts": [
+			{"id": 10, "name": "Engines", "product": "harvest"},
+			{"id": 11, "name": "Engines", "product": "forecast"},
+			{"id": 12, "name": "Looms", "product": "harvest"}
+		]
+	}`))
+}
+
+func (f *fakeHarvestID) refreshCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.refreshes
+}
+
+func TestOAuthAuthCodeURL(t *testing.T) {
+	config := &OAuthConfig{ClientID: "client-id"}
+	got, err := url.Parse(config.AuthCodeURL("xyz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := got.Query()
+	if got.Host != "id.getharvest.com" || q.Get("client_id") != "client-id" || q.Get("response_type") != "code" || q.Get("state") != "xyz" {
+		t.Fatalf("unexpected URL %s", got)
+	}
+}
+
+func TestOAuthExchange(t *testing.T) {
+	f := newFakeHarvestID(t)
+	before := time.Now()
+	token, err := f.config().Exchange(context.Background(), "good-code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-0" || token.TokenType != "bearer" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+	if want := before.Add(1209600 * time.Second); token.Expiry.Before(want) || token.Expiry.After(want.Add(time.Minute)) {
+		t.Fatalf("expiry %v, want about %v", token.Expiry, want)
+	}
+	if form := f.forms[0]; form.Get("grant_type") != "authorization_code" || form.Get("code") != "good-code" {
+		t.Fatalf("unexpected token form %v", form)
+	}
+}
+
+func TestOAuthExchangeError(t *testing.T) {
+	f := newFakeHarvestID(t)
+	_, err := f.config().Exchange(context.Background(), "bad-code")
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "invalid_grant" {
+		t.Fatalf("got %v, want an invalid_grant APIError", err)
+	}
+}
+
+func TestOAuthTokenSourceRefreshesExpiredToken(t *testing.T) {
+	f := newFakeHarvestID(t)
+	f.rotateRefreshToken = false
+	ts := f.config().TokenSource(&Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh-0",
+		Expiry:       time.Now().Add(-time.Hour),
+	})
+	var refreshed []*Token
+	ts.OnRefresh = func(token *Token) {
+		refreshed = append(refreshed, token)
+	}
+
+	token, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-1" {
+		t.Fatalf("got %q, want access-1", token.AccessToken)
+	}
+	// Harvest didn't issue a new refresh token, so the old one is kept
+	if token.RefreshToken != "refresh-0" {
+		t.Fatalf("got refresh token %q, want refresh-0", token.RefreshToken)
+	}
+	if len(refreshed) != 1 || refreshed[0] != token {
+		t.Fatalf("OnRefresh called with %v, want the new token once", refreshed)
+	}
+
+	// The refreshed token is valid, so it's reused
+	if token, err = ts.Token(context.Background()); err != nil || token.AccessToken != "access-1" {
+		t.Fatalf("got %v, %v", token, err)
+	}
+	if f.refreshCount() != 1 {
+		t.Fatalf("got %d refreshes, want 1", f.refreshCount())
+	}
+}
+
+func TestOAuthTokenSourceRefreshSkipsStaleRejection(t *testing.T) {
+	f := newFakeHarvestID(t)
+	ts := f.config().TokenSource(&Token{AccessToken: "current", RefreshToken: "refresh-0"})
+
+	// Rejections of the current token refresh it
+	token, err := ts.Refresh(context.Background(), "current")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+
+	// A second request rejected with the same, now stale, token doesn't
+	// trigger another refresh
+	token, err = ts.Refresh(context.Background(), "current")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access-1" {
+		t.Fatalf("got %q, want access-1", token.AccessToken)
+	}
+	if f.refreshCount() != 1 {
+		t.Fatalf("got %d refreshes, want 1", f.refreshCount())
+	}
+}
+
+func TestAuthMiddlewareRefreshesOn401AndReplaysBody(t *testing.T) {
+	f := newFakeHarvestID(t)
+	var mu sync.Mutex
+	var auths, bodies []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		auths = append(auths, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"invalid_token"}`))
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	})
+	ts := f.config().TokenSource(&Token{AccessToken: "revoked", RefreshToken: "refresh-0"})
+	refreshes := 0
+	ts.OnRefresh = func(*Token) { refreshes++ }
+	c.TokenSource = ts
+
+	res, err := c.Post("/v2/clients", map[string]string{"name": "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if len(auths) != 2 || auths[0] != "Bearer revoked" || auths[1] != "Bearer access-1" {
+		t.Fatalf("got Authorization headers %q", auths)
+	}
+	if bodies[0] == "" || bodies[1] != bodies[0] {
+		t.Fatalf("body not replayed: %q", bodies)
+	}
+	if refreshes != 1 || f.refreshCount() != 1 {
+		t.Fatalf("got %d OnRefresh calls and %d refreshes, want 1", refreshes, f.refreshCount())
+	}
+}
+
+func TestAuthMiddlewareStaticTokenDoesNotRetry401(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := c.Get("/v2/users/me")
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("got %v, want a 401 APIError", err)
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests, want 1", requests)
+	}
+}
+
+func TestOAuthAccountsHarvestAccounts(t *testing.T) {
+	f := newFakeHarvestID(t)
+	config := f.config()
+	token, err := config.Exchange(context.Background(), "good-code")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ar, err := config.Accounts(context.Background(), config.TokenSource(token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.User.Email != "ada@example.com" || len(ar.Accounts) != 3 {
+		t.Fatalf("unexpected accounts response %+v", ar)
+	}
+	harvest := ar.HarvestAccounts()
+	if len(harvest) != 2 || harvest[0].ID != 10 || harvest[1].ID != 12 {
+		t.Fatalf("got %+v, want accounts 10 and 12", harvest)
+	}
+}
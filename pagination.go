@@ -1,5 +1,14 @@
 package goharvest
 
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 // Properties included on a variety of calls to simplify pagination.
 // Harvest uses cursor-based pagination.
 type Pagination struct {
@@ -16,3 +25,220 @@ type Pagination struct {
 		Last     string  `json:"last"`
 	} `json:"links"`
 }
+
+// Returns the pagination properties of a response. Promoted to every
+// response object that embeds Pagination.
+func (p Pagination) PageInfo() Pagination {
+	return p
+}
+
+// Implemented by the response objects of list endpoints, i.e.
+// TimeEntryResponse, so that their pages can be walked generically.
+type Page[T any] interface {
+	Items() []T
+	PageInfo() Pagination
+}
+
+// Returns an iterator over every record of the list endpoint at urlTail,
+// following Links.Next from page to page until the last page. Pages are
+// requested lazily, so breaking out of the loop stops any further
+// requests. If a request fails, the error is yielded and iteration ends.
+//
+//	for te, err := range goharvest.Iterate[goharvest.TimeEntry, goharvest.TimeEntryResponse](ctx, client, "/v2/time_entries") {
+//		...
+//	}
+func Iterate[T any, P Page[T]](ctx context.Context, c *Client, urlTail string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		walkPages[T, P](ctx, c, urlTail, yield)
+	}
+}
+
+// Yields the records of the page at urlTail and of every page after it,
+// following Links.Next, until the last page, an error, or the caller
+// stops iterating.
+func walkPages[T any, P Page[T]](ctx context.Context, c *Client, urlTail string, yield func(T, error) bool) {
+	next := urlTail
+	for next != "" {
+		page, err := getPage[T, P](ctx, c, next)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, item := range page.Items() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		next = ""
+		if link := page.PageInfo().Links.Next; link != nil {
+			next = c.urlTailFromLink(*link)
+		}
+	}
+}
+
+// Like Iterate, but once the first page reports TotalPages, the remaining
+// pages are requested in parallel by up to workers goroutines. Records are
+// still yielded in page order, and at most workers pages are fetched ahead
+// of the caller. Every request still waits on the client's rate limiter, so
+// the workers share the same request budget as the rest of the Client.
+// Breaking out of the loop cancels any requests in flight.
+//
+// The remaining pages are requested by number with the page query
+// parameter, which Harvest has deprecated in favor of cursor-based
+// Links.Next. If the first page's Links.Next has no page parameter, the
+// pages can't be requested by number, so the rest of the list is walked
+// sequentially from that link instead, as Iterate would.
+func IterateConcurrent[T any, P Page[T]](ctx context.Context, c *Client, urlTail string, workers int) iter.Seq2[T, error] {
+	if workers < 2 {
+		return Iterate[T, P](ctx, c, urlTail)
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		first, err := getPage[T, P](ctx, c, urlTail)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		for _, item := range first.Items() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		info := first.PageInfo()
+		if info.TotalPages <= info.Page || info.Links.Next == nil {
+			return
+		}
+		if !hasPageParam(*info.Links.Next) {
+			walkPages[T, P](ctx, c, c.urlTailFromLink(*info.Links.Next), yield)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type pageResult struct {
+			page P
+			err  error
+		}
+		remaining := info.TotalPages - info.Page
+		results := make([]chan pageResult, remaining)
+		for i := range results {
+			results[i] = make(chan pageResult, 1)
+		}
+		slots := make(chan struct{}, workers)
+		go func() {
+			for i := range results {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				pageTail := withPage(urlTail, info.Page+1+i)
+				go func(out chan<- pageResult) {
+					page, err := getPage[T, P](ctx, c, pageTail)
+					out <- pageResult{page, err}
+				}(results[i])
+			}
+		}()
+
+		for i := range results {
+			var result pageResult
+			select {
+			case result = <-results[i]:
+			case <-ctx.Done():
+				yield(zero, ctx.Err())
+				return
+			}
+			<-slots
+			if result.err != nil {
+				yield(zero, result.err)
+				return
+			}
+			for _, item := range result.page.Items() {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collects the records of an iterator, such as one returned by Iterate,
+// into a slice. If max is greater than zero, at most max records are
+// collected and no further pages are requested. The records collected
+// before an error are returned along with it.
+func ListAll[T any](seq iter.Seq2[T, error], max int) ([]T, error) {
+	items := []T{}
+	if max > 0 {
+		items = make([]T, 0, max)
+	}
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// Requests and decodes a single page of a list endpoint.
+func getPage[T any, P Page[T]](ctx context.Context, c *Client, urlTail string) (P, error) {
+	var page P
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return page, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&page)
+	if err != nil {
+		return page, err
+	}
+	return page, nil
+}
+
+// Converts a pagination link, which Harvest returns as an absolute URL,
+// into a urlTail relative to the client's BasePath.
+func (c *Client) urlTailFromLink(link string) string {
+	if strings.HasPrefix(link, c.BasePath) {
+		return strings.TrimPrefix(link, c.BasePath)
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	return u.RequestURI()
+}
+
+// Whether a pagination link selects its page with the page query
+// parameter, rather than with a cursor.
+func hasPageParam(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return u.Query().Has("page")
+}
+
+// Sets the page query parameter on a urlTail.
+func withPage(urlTail string, page int) string {
+	u, err := url.Parse(urlTail)
+	if err != nil {
+		return urlTail
+	}
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// Returns an iterator that yields only the given error.
+func errorSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -0,0 +1,263 @@
+package goharvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testPerPage = 2
+
+// Writes page n of a list of clients with IDs 1 through perPage*totalPages.
+// The next link is built by nextLink, or is null on the last page.
+func writeClientsPage(w http.ResponseWriter, n int, totalPages int, nextLink func(n int) string) {
+	ids := []string{}
+	for i := 1; i <= testPerPage; i++ {
+		ids = append(ids, fmt.Sprintf(`{"id":%d}`, (n-1)*testPerPage+i))
+	}
+	next := "null"
+	if n < totalPages {
+		next = strconv.Quote(nextLink(n))
+	}
+	fmt.Fprintf(w, `{"clients":[%s],"per_page":%d,"total_pages":%d,"total_entries":%d,"page":%d,"links":{"next":%s}}`,
+		strings.Join(ids, ","), testPerPage, totalPages, testPerPage*totalPages, n, next)
+}
+
+// Returns the page number requested, defaulting to 1.
+func requestedPage(r *http.Request) int {
+	n, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		return 1
+	}
+	return n
+}
+
+func collectIDs(t *testing.T, seq func(func(ClientResource, error) bool)) ([]int, error) {
+	t.Helper()
+	ids := []int{}
+	for cl, err := range seq {
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, cl.ID)
+	}
+	return ids, nil
+}
+
+func assertSequentialIDs(t *testing.T, ids []int, want int) {
+	t.Helper()
+	if len(ids) != want {
+		t.Fatalf("got %d records, want %d: %v", len(ids), want, ids)
+	}
+	for i, id := range ids {
+		if id != i+1 {
+			t.Fatalf("records out of order: %v", ids)
+		}
+	}
+}
+
+func TestIterateFollowsNextLinks(t *testing.T) {
+	const totalPages = 3
+	var base string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeClientsPage(w, requestedPage(r), totalPages, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?page=%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+
+	ids, err := collectIDs(t, Iterate[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSequentialIDs(t, ids, totalPages*testPerPage)
+}
+
+func TestIterateConcurrentYieldsInPageOrder(t *testing.T) {
+	const totalPages = 6
+	var base string
+	var inFlight, maxInFlight atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		n := requestedPage(r)
+		// Later pages respond faster than earlier ones
+		time.Sleep(time.Duration(totalPages-n) * 10 * time.Millisecond)
+		writeClientsPage(w, n, totalPages, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?page=%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+
+	ids, err := collectIDs(t, IterateConcurrent[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients", 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSequentialIDs(t, ids, totalPages*testPerPage)
+	if got := maxInFlight.Load(); got < 2 || got > 3 {
+		t.Fatalf("got %d requests in flight at once, want 2 to 3", got)
+	}
+}
+
+func TestIterateConcurrentBreakCancelsInFlightRequests(t *testing.T) {
+	const totalPages = 5
+	var base string
+	var cancelled atomic.Int32
+	var blocked sync.WaitGroup
+	blocked.Add(2)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := requestedPage(r)
+		if n >= 3 {
+			// Hold later pages open until the client goes away
+			blocked.Done()
+			select {
+			case <-r.Context().Done():
+				cancelled.Add(1)
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		writeClientsPage(w, n, totalPages, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?page=%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+
+	for cl, err := range IterateConcurrent[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients", 3) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cl.ID == testPerPage+1 {
+			// Wait until pages 3 and 4 are being fetched before breaking
+			blocked.Wait()
+			break
+		}
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for cancelled.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := cancelled.Load(); got != 2 {
+		t.Fatalf("got %d cancelled requests, want 2", got)
+	}
+}
+
+func TestIterateConcurrentStopsOnError(t *testing.T) {
+	const totalPages = 5
+	var base string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := requestedPage(r)
+		if n == 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeClientsPage(w, n, totalPages, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?page=%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+
+	ids, err := collectIDs(t, IterateConcurrent[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients", 3))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("got %v, want a 404 APIError", err)
+	}
+	assertSequentialIDs(t, ids, 2*testPerPage)
+}
+
+// Serves a list whose next links use a cursor rather than a page number,
+// recording the query of every request.
+func cursorListClient(t *testing.T, totalPages int) (*Client, *[]string, *sync.Mutex) {
+	var base string
+	var mu sync.Mutex
+	queries := []string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+		n := 1
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			n, _ = strconv.Atoi(strings.TrimPrefix(cursor, "c"))
+		}
+		writeClientsPage(w, n, totalPages, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?cursor=c%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+	return c, &queries, &mu
+}
+
+func TestIterateConcurrentFallsBackForCursorLinks(t *testing.T) {
+	const totalPages = 4
+	c, queries, mu := cursorListClient(t, totalPages)
+
+	ids, err := collectIDs(t, IterateConcurrent[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients", 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSequentialIDs(t, ids, totalPages*testPerPage)
+	mu.Lock()
+	defer mu.Unlock()
+	for _, q := range (*queries)[1:] {
+		if !strings.HasPrefix(q, "cursor=") {
+			t.Fatalf("requested %q, want only cursor links after the first page", q)
+		}
+	}
+}
+
+func TestIterateConcurrentWithOneWorkerIsSequential(t *testing.T) {
+	const totalPages = 3
+	c, queries, mu := cursorListClient(t, totalPages)
+
+	for _, workers := range []int{0, 1} {
+		mu.Lock()
+		*queries = (*queries)[:0]
+		mu.Unlock()
+		ids, err := collectIDs(t, IterateConcurrent[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients", workers))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSequentialIDs(t, ids, totalPages*testPerPage)
+		mu.Lock()
+		want := []string{"", "cursor=c2", "cursor=c3"}
+		if strings.Join(*queries, "|") != strings.Join(want, "|") {
+			t.Fatalf("workers=%d: requested %q, want %q", workers, *queries, want)
+		}
+		mu.Unlock()
+	}
+}
+
+func TestListAllStopsAtMax(t *testing.T) {
+	var requests atomic.Int32
+	var base string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		writeClientsPage(w, requestedPage(r), 5, func(n int) string {
+			return fmt.Sprintf("%s/v2/clients?page=%d", base, n+1)
+		})
+	})
+	base = c.BasePath
+
+	items, err := ListAll(Iterate[ClientResource, ClientResourceResponse](context.Background(), c, "/v2/clients"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || requests.Load() != 2 {
+		t.Fatalf("got %d items from %d requests, want 3 from 2", len(items), requests.Load())
+	}
+}
@@ -0,0 +1,420 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// The methods by which a project can be billed.
+const (
+	ProjectBillByProject = "Project"
+	ProjectBillByTasks   = "Tasks"
+	ProjectBillByPeople  = "People"
+	ProjectBillByNone    = "none"
+)
+
+// The methods by which a project's budget can be tracked.
+const (
+	ProjectBudgetByProject     = "project"
+	ProjectBudgetByProjectCost = "project_cost"
+	ProjectBudgetByTask        = "task"
+	ProjectBudgetByTaskFees    = "task_fees"
+	ProjectBudgetByPerson      = "person"
+	ProjectBudgetByNone        = "none"
+)
+
+// A response object from requesting projects
+type ProjectResponse struct {
+	Projects []Project `json:"projects"`
+	Pagination
+}
+
+func (pr ProjectResponse) Items() []Project {
+	return pr.Projects
+}
+
+type ProjectClient struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+}
+
+type Project struct {
+	// Unique ID for the project.
+	ID int `json:"id"`
+
+	// An object containing the project’s client id, name, and currency.
+	Client ProjectClient `json:"client"`
+
+	// Unique name for the project.
+	Name string `json:"name"`
+
+	// The code associated with the project.
+	Code string `json:"code"`
+
+	// Whether the project is active or archived.
+	IsActive bool `json:"is_active"`
+
+	// Whether the project is billable or not.
+	IsBillable bool `json:"is_billable"`
+
+	// Whether the project is a fixed-fee project or not.
+	IsFixedFee bool `json:"is_fixed_fee"`
+
+	// The method by which the project is invoiced. See the ProjectBillBy
+	// constants.
+	BillBy string `json:"bill_by"`
+
+	// Rate for projects billed by Project Hourly Rate.
+	HourlyRate *float64 `json:"hourly_rate"`
+
+	// The budget in hours for the project when budgeting by time.
+	Budget *float64 `json:"budget"`
+
+	// The method by which the project is budgeted. See the ProjectBudgetBy
+	// constants.
+	BudgetBy string `json:"budget_by"`
+
+	// Option to have the budget reset every month.
+	BudgetIsMonthly bool `json:"budget_is_monthly"`
+
+	// Whether Project Managers should be notified when the project goes
+	// over budget.
+	NotifyWhenOverBudget bool `json:"notify_when_over_budget"`
+
+	// Percentage value used to trigger over budget email alerts.
+	OverBudgetNotificationPercentage float64 `json:"over_budget_notification_percentage"`
+
+	// Date of last over budget notification. If none have been sent, this
+	// will be null.
+	OverBudgetNotificationDate *Date `json:"over_budget_notification_date"`
+
+	// Option to show project budget to all employees. Does not apply to
+	// Total Project Fee projects.
+	ShowBudgetToAll bool `json:"show_budget_to_all"`
+
+	// The monetary budget for the project when budgeting by money.
+	CostBudget *float64 `json:"cost_budget"`
+
+	// Option for budget of Total Project Fees projects to include tracked
+	// expenses.
+	CostBudgetIncludeExpenses bool `json:"cost_budget_include_expenses"`
+
+	// The amount you plan to invoice for the project. Only used by
+	// fixed-fee projects.
+	Fee *float64 `json:"fee"`
+
+	// Project notes.
+	Notes string `json:"notes"`
+
+	// Date the project was started.
+	StartsOn *Date `json:"starts_on"`
+
+	// Date the project will end.
+	EndsOn *Date `json:"ends_on"`
+
+	// Date and time the project was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the project was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetProjectParameters struct {
+	// Pass true to only return active projects and false to return
+	// inactive projects.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return projects belonging to the client with the given ID.
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// Only return projects that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your projects. The projects are returned sorted by
+// creation date, with the most recently created projects appearing first.
+func (c *Client) GetProjects(params GetProjectParameters) (ProjectResponse, error) {
+	return c.GetProjectsWithContext(context.Background(), params)
+}
+
+// GetProjects, bound to the provided context.
+func (c *Client) GetProjectsWithContext(ctx context.Context, params GetProjectParameters) (ProjectResponse, error) {
+	pr := ProjectResponse{}
+	urlTail, err := buildPathWithParams[GetProjectParameters]("/v2/projects", params)
+	if err != nil {
+		return pr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return pr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&pr)
+	if err != nil {
+		return pr, err
+	}
+	return pr, nil
+}
+
+// Returns an iterator over every project matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterProjects(ctx context.Context, params GetProjectParameters) iter.Seq2[Project, error] {
+	urlTail, err := buildPathWithParams[GetProjectParameters]("/v2/projects", params)
+	if err != nil {
+		return errorSeq[Project](err)
+	}
+	return Iterate[Project, ProjectResponse](ctx, c, urlTail)
+}
+
+// Retrieves the project with the given ID. Returns a project object and a
+// 200 OK response code if a valid identifier was provided.
+func (c *Client) GetProject(id int) (Project, error) {
+	return c.GetProjectWithContext(context.Background(), id)
+}
+
+// GetProject, bound to the provided context.
+func (c *Client) GetProjectWithContext(ctx context.Context, id int) (Project, error) {
+	p := Project{}
+	urlTail := fmt.Sprintf("/v2/projects/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return p, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&p)
+	if err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+type CreateProjectBody struct {
+	// The ID of the client to associate this project with. - required
+	ClientID int `json:"client_id" url:"client_id,omitempty"`
+
+	// The name of the project. - required
+	Name string `json:"name" url:"name,omitempty"`
+
+	// The code associated with the project. - optional
+	Code string `json:"code,omitempty" url:"code,omitempty"`
+
+	// Whether the project is active or archived. Defaults to
+	// true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Whether the project is billable or not. - required
+	IsBillable bool `json:"is_billable" url:"is_billable,omitempty"`
+
+	// Whether the project is a fixed-fee project or not. - optional
+	IsFixedFee *bool `json:"is_fixed_fee,omitempty" url:"is_fixed_fee,omitempty"`
+
+	// The method by which the project is invoiced. Options: Project, Tasks,
+	// People, or none. See the ProjectBillBy constants. - required
+	BillBy string `json:"bill_by" url:"bill_by,omitempty"`
+
+	// Rate for projects billed by Project Hourly Rate. - optional
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// The budget in hours for the project when budgeting by
+	// time. - optional
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+
+	// The method by which the project is budgeted. Options: project
+	// (Hours Per Project), project_cost (Total Project Fees), task (Hours
+	// Per Task), task_fees (Fees Per Task), person (Hours Per Person), none
+	// (No Budget). See the ProjectBudgetBy constants. - required
+	BudgetBy string `json:"budget_by" url:"budget_by,omitempty"`
+
+	// Option to have the budget reset every month. Defaults to
+	// false. - optional
+	BudgetIsMonthly *bool `json:"budget_is_monthly,omitempty" url:"budget_is_monthly,omitempty"`
+
+	// Whether Project Managers should be notified when the project goes
+	// over budget. Defaults to false. - optional
+	NotifyWhenOverBudget *bool `json:"notify_when_over_budget,omitempty" url:"notify_when_over_budget,omitempty"`
+
+	// Percentage value used to trigger over budget email alerts. Example:
+	// use 10.0 for 10.0%. - optional
+	OverBudgetNotificationPercentage *float64 `json:"over_budget_notification_percentage,omitempty" url:"over_budget_notification_percentage,omitempty"`
+
+	// Option to show project budget to all employees. Does not apply to
+	// Total Project Fee projects. Defaults to false. - optional
+	ShowBudgetToAll *bool `json:"show_budget_to_all,omitempty" url:"show_budget_to_all,omitempty"`
+
+	// The monetary budget for the project when budgeting by
+	// money. - optional
+	CostBudget *float64 `json:"cost_budget,omitempty" url:"cost_budget,omitempty"`
+
+	// Option for budget of Total Project Fees projects to include tracked
+	// expenses. Defaults to false. - optional
+	CostBudgetIncludeExpenses *bool `json:"cost_budget_include_expenses,omitempty" url:"cost_budget_include_expenses,omitempty"`
+
+	// The amount you plan to invoice for the project. Only used by
+	// fixed-fee projects. - optional
+	Fee *float64 `json:"fee,omitempty" url:"fee,omitempty"`
+
+	// Project notes. - optional
+	Notes string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// Date the project was started. - optional
+	StartsOn *Date `json:"starts_on,omitempty" url:"starts_on,omitempty"`
+
+	// Date the project will end. - optional
+	EndsOn *Date `json:"ends_on,omitempty" url:"ends_on,omitempty"`
+}
+
+func (b CreateProjectBody) IsValid() bool {
+	return b.ClientID != 0 && b.Name != "" && b.BillBy != "" && b.BudgetBy != ""
+}
+
+// Creates a new project object. Returns a project object and a 201 Created
+// response code if the call succeeded.
+func (c *Client) CreateProject(body CreateProjectBody) (Project, error) {
+	return c.CreateProjectWithContext(context.Background(), body)
+}
+
+// CreateProject, bound to the provided context.
+func (c *Client) CreateProjectWithContext(ctx context.Context, body CreateProjectBody) (Project, error) {
+	p := Project{}
+	if !body.IsValid() {
+		return p, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/projects", body)
+	if err != nil {
+		return p, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&p)
+	if err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+type UpdateProjectBody struct {
+	// The ID of the client to associate this project with.
+	ClientID *int `json:"client_id,omitempty" url:"client_id,omitempty"`
+
+	// The name of the project.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+
+	// The code associated with the project.
+	Code *string `json:"code,omitempty" url:"code,omitempty"`
+
+	// Whether the project is active or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Whether the project is billable or not.
+	IsBillable *bool `json:"is_billable,omitempty" url:"is_billable,omitempty"`
+
+	// Whether the project is a fixed-fee project or not.
+	IsFixedFee *bool `json:"is_fixed_fee,omitempty" url:"is_fixed_fee,omitempty"`
+
+	// The method by which the project is invoiced. See the ProjectBillBy
+	// constants.
+	BillBy *string `json:"bill_by,omitempty" url:"bill_by,omitempty"`
+
+	// Rate for projects billed by Project Hourly Rate.
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// The budget in hours for the project when budgeting by time.
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+
+	// The method by which the project is budgeted. See the ProjectBudgetBy
+	// constants.
+	BudgetBy *string `json:"budget_by,omitempty" url:"budget_by,omitempty"`
+
+	// Option to have the budget reset every month.
+	BudgetIsMonthly *bool `json:"budget_is_monthly,omitempty" url:"budget_is_monthly,omitempty"`
+
+	// Whether Project Managers should be notified when the project goes
+	// over budget.
+	NotifyWhenOverBudget *bool `json:"notify_when_over_budget,omitempty" url:"notify_when_over_budget,omitempty"`
+
+	// Percentage value used to trigger over budget email alerts. Example:
+	// use 10.0 for 10.0%.
+	OverBudgetNotificationPercentage *float64 `json:"over_budget_notification_percentage,omitempty" url:"over_budget_notification_percentage,omitempty"`
+
+	// Option to show project budget to all employees. Does not apply to
+	// Total Project Fee projects.
+	ShowBudgetToAll *bool `json:"show_budget_to_all,omitempty" url:"show_budget_to_all,omitempty"`
+
+	// The monetary budget for the project when budgeting by money.
+	CostBudget *float64 `json:"cost_budget,omitempty" url:"cost_budget,omitempty"`
+
+	// Option for budget of Total Project Fees projects to include tracked
+	// expenses.
+	CostBudgetIncludeExpenses *bool `json:"cost_budget_include_expenses,omitempty" url:"cost_budget_include_expenses,omitempty"`
+
+	// The amount you plan to invoice for the project. Only used by
+	// fixed-fee projects.
+	Fee *float64 `json:"fee,omitempty" url:"fee,omitempty"`
+
+	// Project notes.
+	Notes *string `json:"notes,omitempty" url:"notes,omitempty"`
+
+	// Date the project was started.
+	StartsOn *Date `json:"starts_on,omitempty" url:"starts_on,omitempty"`
+
+	// Date the project will end.
+	EndsOn *Date `json:"ends_on,omitempty" url:"ends_on,omitempty"`
+}
+
+func (b UpdateProjectBody) IsValid() bool {
+	return b.Name == nil || *b.Name != ""
+}
+
+// Updates the specific project by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns a
+// project object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateProject(id int, body UpdateProjectBody) (Project, error) {
+	return c.UpdateProjectWithContext(context.Background(), id, body)
+}
+
+// UpdateProject, bound to the provided context.
+func (c *Client) UpdateProjectWithContext(ctx context.Context, id int, body UpdateProjectBody) (Project, error) {
+	p := Project{}
+	if !body.IsValid() {
+		return p, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/projects/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return p, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&p)
+	if err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// Deletes a project and any time entries or expenses tracked to it.
+// However, if there are time entries or expenses tracked to the project
+// that have been included in an invoice, the project cannot be deleted.
+// Returns a 200 OK response code if the call succeeded.
+func (c *Client) DeleteProject(id int) error {
+	return c.DeleteProjectWithContext(context.Background(), id)
+}
+
+// DeleteProject, bound to the provided context.
+func (c *Client) DeleteProjectWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/projects/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
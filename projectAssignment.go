@@ -7,6 +7,10 @@ type ProjectAssignmentResponse struct {
 	Pagination
 }
 
+func (pa ProjectAssignmentResponse) Items() []ProjectAssignment {
+	return pa.ProjectAssignments
+}
+
 type ProjectAssignmentProject struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
@@ -0,0 +1,267 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting a user's billable rates
+type BillableRateResponse struct {
+	BillableRates []Rate `json:"billable_rates"`
+	Pagination
+}
+
+func (br BillableRateResponse) Items() []Rate {
+	return br.BillableRates
+}
+
+// A response object from requesting a user's cost rates
+type CostRateResponse struct {
+	CostRates []Rate `json:"cost_rates"`
+	Pagination
+}
+
+func (cr CostRateResponse) Items() []Rate {
+	return cr.CostRates
+}
+
+// An entry in a user's billable rate or cost rate history
+type Rate struct {
+	// Unique ID for the rate.
+	ID int `json:"id"`
+
+	// The amount of the rate.
+	Amount float64 `json:"amount"`
+
+	// The date the rate is effective. Null for the user's original rate,
+	// which is effective from the start.
+	StartDate *Date `json:"start_date"`
+
+	// The date the rate is no longer effective. Null for the user's current
+	// rate. This date is calculated by Harvest.
+	EndDate *Date `json:"end_date"`
+
+	// Date and time the rate was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the rate was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Returns the rate from a user's rate history that was effective on the
+// given date, i.e. the SpentDate of a TimeEntry, and whether one was
+// found. When several rates apply, the one with the latest StartDate wins.
+//
+//	rates, err := goharvest.ListAll(client.IterCostRates(ctx, userID, goharvest.GetRateParameters{}), 0)
+//	rate, ok := goharvest.EffectiveRate(rates, timeEntry.SpentDate)
+func EffectiveRate(rates []Rate, on Date) (Rate, bool) {
+	day := on.Format(time.DateOnly)
+	var effective Rate
+	found := false
+	for _, r := range rates {
+		if r.StartDate != nil && r.StartDate.Format(time.DateOnly) > day {
+			continue
+		}
+		if r.EndDate != nil && r.EndDate.Format(time.DateOnly) < day {
+			continue
+		}
+		if found && !startsAfter(r, effective) {
+			continue
+		}
+		effective = r
+		found = true
+	}
+	return effective, found
+}
+
+// Whether rate a starts later than rate b. A rate without a StartDate
+// starts before any other.
+func startsAfter(a Rate, b Rate) bool {
+	if a.StartDate == nil {
+		return false
+	}
+	if b.StartDate == nil {
+		return true
+	}
+	return a.StartDate.Format(time.DateOnly) > b.StartDate.Format(time.DateOnly)
+}
+
+type GetRateParameters struct {
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+type CreateRateBody struct {
+	// The amount of the rate. Must be zero or more. - required
+	Amount *float64 `json:"amount" url:"amount,omitempty"`
+
+	// The date the rate is effective. Cannot be a date in the future.
+	// Defaults to today. - optional
+	StartDate *Date `json:"start_date,omitempty" url:"start_date,omitempty"`
+}
+
+func (b CreateRateBody) IsValid() bool {
+	return b.Amount != nil && *b.Amount >= 0
+}
+
+// Returns a list of billable rates for the user with the given ID. The
+// billable rates are returned sorted by start_date, with the oldest
+// starting billable rates appearing first.
+func (c *Client) GetBillableRates(userID int, params GetRateParameters) (BillableRateResponse, error) {
+	return c.GetBillableRatesWithContext(context.Background(), userID, params)
+}
+
+// GetBillableRates, bound to the provided context.
+func (c *Client) GetBillableRatesWithContext(ctx context.Context, userID int, params GetRateParameters) (BillableRateResponse, error) {
+	br := BillableRateResponse{}
+	urlTail, err := buildPathWithParams[GetRateParameters](fmt.Sprintf("/v2/users/%d/billable_rates", userID), params)
+	if err != nil {
+		return br, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return br, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&br)
+	if err != nil {
+		return br, err
+	}
+	return br, nil
+}
+
+// Returns an iterator over every billable rate for the user with the given
+// ID, requesting each page as it is needed. See Iterate.
+func (c *Client) IterBillableRates(ctx context.Context, userID int, params GetRateParameters) iter.Seq2[Rate, error] {
+	urlTail, err := buildPathWithParams[GetRateParameters](fmt.Sprintf("/v2/users/%d/billable_rates", userID), params)
+	if err != nil {
+		return errorSeq[Rate](err)
+	}
+	return Iterate[Rate, BillableRateResponse](ctx, c, urlTail)
+}
+
+// Retrieves the billable rate with the given ID for the given user.
+// Returns a billable rate object and a 200 OK response code if a valid
+// identifier was provided.
+func (c *Client) GetBillableRate(userID int, id int) (Rate, error) {
+	return c.GetBillableRateWithContext(context.Background(), userID, id)
+}
+
+// GetBillableRate, bound to the provided context.
+func (c *Client) GetBillableRateWithContext(ctx context.Context, userID int, id int) (Rate, error) {
+	return c.getRate(ctx, fmt.Sprintf("/v2/users/%d/billable_rates/%d", userID, id))
+}
+
+// Creates a new billable rate object for the given user. Returns a
+// billable rate object and a 201 Created response code if the call
+// succeeded. Creating a billable rate with no start date will replace a
+// user’s existing rate(s).
+func (c *Client) CreateBillableRate(userID int, body CreateRateBody) (Rate, error) {
+	return c.CreateBillableRateWithContext(context.Background(), userID, body)
+}
+
+// CreateBillableRate, bound to the provided context.
+func (c *Client) CreateBillableRateWithContext(ctx context.Context, userID int, body CreateRateBody) (Rate, error) {
+	return c.createRate(ctx, fmt.Sprintf("/v2/users/%d/billable_rates", userID), body)
+}
+
+// Returns a list of cost rates for the user with the given ID. The cost
+// rates are returned sorted by start_date, with the oldest starting cost
+// rates appearing first.
+func (c *Client) GetCostRates(userID int, params GetRateParameters) (CostRateResponse, error) {
+	return c.GetCostRatesWithContext(context.Background(), userID, params)
+}
+
+// GetCostRates, bound to the provided context.
+func (c *Client) GetCostRatesWithContext(ctx context.Context, userID int, params GetRateParameters) (CostRateResponse, error) {
+	cr := CostRateResponse{}
+	urlTail, err := buildPathWithParams[GetRateParameters](fmt.Sprintf("/v2/users/%d/cost_rates", userID), params)
+	if err != nil {
+		return cr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return cr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&cr)
+	if err != nil {
+		return cr, err
+	}
+	return cr, nil
+}
+
+// Returns an iterator over every cost rate for the user with the given ID,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterCostRates(ctx context.Context, userID int, params GetRateParameters) iter.Seq2[Rate, error] {
+	urlTail, err := buildPathWithParams[GetRateParameters](fmt.Sprintf("/v2/users/%d/cost_rates", userID), params)
+	if err != nil {
+		return errorSeq[Rate](err)
+	}
+	return Iterate[Rate, CostRateResponse](ctx, c, urlTail)
+}
+
+// Retrieves the cost rate with the given ID for the given user. Returns a
+// cost rate object and a 200 OK response code if a valid identifier
+// was provided.
+func (c *Client) GetCostRate(userID int, id int) (Rate, error) {
+	return c.GetCostRateWithContext(context.Background(), userID, id)
+}
+
+// GetCostRate, bound to the provided context.
+func (c *Client) GetCostRateWithContext(ctx context.Context, userID int, id int) (Rate, error) {
+	return c.getRate(ctx, fmt.Sprintf("/v2/users/%d/cost_rates/%d", userID, id))
+}
+
+// Creates a new cost rate object for the given user. Returns a cost rate
+// object and a 201 Created response code if the call succeeded. Creating
+// a cost rate with no start date will replace a user’s existing rate(s).
+func (c *Client) CreateCostRate(userID int, body CreateRateBody) (Rate, error) {
+	return c.CreateCostRateWithContext(context.Background(), userID, body)
+}
+
+// CreateCostRate, bound to the provided context.
+func (c *Client) CreateCostRateWithContext(ctx context.Context, userID int, body CreateRateBody) (Rate, error) {
+	return c.createRate(ctx, fmt.Sprintf("/v2/users/%d/cost_rates", userID), body)
+}
+
+func (c *Client) getRate(ctx context.Context, urlTail string) (Rate, error) {
+	r := Rate{}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return r, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&r)
+	if err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+func (c *Client) createRate(ctx context.Context, urlTail string, body CreateRateBody) (Rate, error) {
+	r := Rate{}
+	if !body.IsValid() {
+		return r, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return r, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&r)
+	if err != nil {
+		return r, err
+	}
+	return r, nil
+}
@@ -0,0 +1,152 @@
+package goharvest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Harvest's published limit for the general API: 100 requests per
+// 15 seconds.
+const (
+	DefaultRateLimitRequests = 100
+	DefaultRateLimitInterval = 15 * time.Second
+)
+
+// Harvest's published limit for the Reports API: 100 requests per
+// 15 minutes.
+const (
+	ReportsRateLimitRequests = 100
+	ReportsRateLimitInterval = 15 * time.Minute
+)
+
+// A token bucket used to pace requests on the client side so that the
+// server-side limit is never reached. The bucket holds up to Capacity
+// tokens and refills continuously at Capacity tokens per Interval. It is
+// safe for concurrent use, so one limiter can be shared by every goroutine
+// using the same Client.
+type RateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+// A snapshot of a RateLimiter's bucket, for observability.
+type RateLimiterState struct {
+	// The maximum number of tokens the bucket can hold.
+	Capacity int
+
+	// The period over which a full bucket's worth of tokens is refilled.
+	Interval time.Duration
+
+	// The number of tokens currently available. This is negative when
+	// callers are queued waiting for tokens.
+	Available float64
+
+	// How long a new request would currently have to wait for a token.
+	Wait time.Duration
+}
+
+// Create a new RateLimiter allowing the given number of requests per
+// interval. The bucket starts full.
+func NewRateLimiter(requests int, interval time.Duration) *RateLimiter {
+	return &RateLimiter{
+		capacity: float64(requests),
+		interval: interval,
+		tokens:   float64(requests),
+		last:     time.Now(),
+	}
+}
+
+// The number of tokens added to the bucket per second.
+func (l *RateLimiter) rate() float64 {
+	return l.capacity / l.interval.Seconds()
+}
+
+// Adds the tokens accrued since the last refill. The caller must hold
+// the lock.
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	l.tokens += elapsed * l.rate()
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+}
+
+// The time needed for the bucket to climb back to zero tokens. The caller
+// must hold the lock.
+func (l *RateLimiter) deficit() time.Duration {
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate() * float64(time.Second))
+}
+
+// Blocks until a token is available or the context is done. Tokens are
+// reserved in call order, so waiting goroutines are served fairly. If the
+// context ends before the token becomes available, the reservation is
+// returned to the bucket.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	l.mu.Lock()
+	l.refill(time.Now())
+	l.tokens--
+	wait := l.deficit()
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		l.mu.Lock()
+		l.refill(time.Now())
+		l.tokens++
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Returns a snapshot of the bucket's current state. A nil limiter, which
+// disables pacing, returns the zero RateLimiterState.
+func (l *RateLimiter) State() RateLimiterState {
+	if l == nil {
+		return RateLimiterState{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill(time.Now())
+	return RateLimiterState{
+		Capacity:  int(l.capacity),
+		Interval:  l.interval,
+		Available: l.tokens,
+		Wait:      l.deficit(),
+	}
+}
+
+// Returns the built-in Middleware that waits on a rate limiter before each
+// request is sent. Requests to the Reports API draw from reports, and all
+// others from general; a nil limiter disables pacing for those requests.
+// Every Client includes it, configured from Client.Limiter and
+// Client.ReportsLimiter.
+func RateLimitMiddleware(general *RateLimiter, reports *RateLimiter) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			limiter := general
+			if strings.Contains(req.URL.Path, "/v2/reports") {
+				limiter = reports
+			}
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
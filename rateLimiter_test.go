@@ -0,0 +1,88 @@
+package goharvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsBurstUpToCapacity(t *testing.T) {
+	l := NewRateLimiter(5, time.Hour)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("burst took %v", elapsed)
+	}
+	if s := l.State(); s.Capacity != 5 || s.Available >= 1 {
+		t.Fatalf("unexpected state after burst: %+v", s)
+	}
+}
+
+func TestRateLimiterPacesOnceEmpty(t *testing.T) {
+	l := NewRateLimiter(2, 100*time.Millisecond)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Two tokens up front, then one every 50ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("4 requests took %v, want at least ~100ms", elapsed)
+	}
+}
+
+func TestRateLimiterWaitReturnsTokenOnCancel(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want deadline exceeded", err)
+	}
+	if s := l.State(); s.Available < -0.01 {
+		t.Fatalf("cancelled reservation not returned: %+v", s)
+	}
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var l *RateLimiter
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s := l.State(); s != (RateLimiterState{}) {
+		t.Fatalf("got %+v, want the zero state", s)
+	}
+}
+
+func TestRateLimitMiddlewarePicksReportsLimiter(t *testing.T) {
+	general := NewRateLimiter(10, time.Hour)
+	reports := NewRateLimiter(10, time.Hour)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	c.Limiter = general
+	c.ReportsLimiter = reports
+
+	for _, path := range []string{"/v2/clients", "/v2/reports/time/clients", "/v2/reports/time/team"} {
+		res, err := c.Get(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+	if got := general.State().Available; got < 8.9 || got > 9.1 {
+		t.Fatalf("general limiter has %v tokens, want 9", got)
+	}
+	if got := reports.State().Available; got < 7.9 || got > 8.1 {
+		t.Fatalf("reports limiter has %v tokens, want 8", got)
+	}
+}
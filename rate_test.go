@@ -0,0 +1,79 @@
+package goharvest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCreateRateBodyIsValid(t *testing.T) {
+	amount := 150.0
+	zero := 0.0
+	negative := -1.0
+	tests := []struct {
+		name string
+		body CreateRateBody
+		want bool
+	}{
+		{"amount", CreateRateBody{Amount: &amount}, true},
+		{"zero amount", CreateRateBody{Amount: &zero}, true},
+		{"negative amount", CreateRateBody{Amount: &negative}, false},
+		{"missing amount", CreateRateBody{}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.body.IsValid(); got != tt.want {
+			t.Errorf("%s: IsValid() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func rateDay(s string) *Date {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return &Date{t}
+}
+
+func TestEffectiveRate(t *testing.T) {
+	// An original rate, replaced on 2024-03-01, which is the day the
+	// original rate ends
+	history := []Rate{
+		{ID: 2, Amount: 120, StartDate: rateDay("2024-03-01")},
+		{ID: 1, Amount: 100, EndDate: rateDay("2024-03-01")},
+	}
+	// A backdated rate that overlaps an older one
+	overlapping := []Rate{
+		{ID: 1, Amount: 100, StartDate: rateDay("2024-01-01")},
+		{ID: 2, Amount: 150, StartDate: rateDay("2024-02-01")},
+	}
+	dated := []Rate{
+		{ID: 1, Amount: 100, StartDate: rateDay("2024-01-01"), EndDate: rateDay("2024-01-31")},
+		{ID: 2, Amount: 120, StartDate: rateDay("2024-02-01")},
+	}
+	tests := []struct {
+		name   string
+		rates  []Rate
+		on     string
+		wantID int
+	}{
+		{"original rate without a start date", history, "2020-06-15", 1},
+		{"day before the boundary", history, "2024-02-29", 1},
+		{"boundary day", history, "2024-03-01", 2},
+		{"after the boundary", history, "2024-07-04", 2},
+		{"before the overlap", overlapping, "2024-01-15", 1},
+		{"overlap goes to the latest start", overlapping, "2024-02-15", 2},
+		{"before every rate", dated, "2023-12-31", 0},
+		{"first day of the first rate", dated, "2024-01-01", 1},
+		{"no rates", nil, "2024-01-01", 0},
+	}
+	for _, tt := range tests {
+		got, found := EffectiveRate(tt.rates, *rateDay(tt.on))
+		if found != (tt.wantID != 0) {
+			t.Errorf("%s: found = %v, want %v", tt.name, found, tt.wantID != 0)
+			continue
+		}
+		if got.ID != tt.wantID {
+			t.Errorf("%s: got rate %d, want %d", tt.name, got.ID, tt.wantID)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package goharvest
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Controls how the Client retries requests that Harvest throttled (429) or
+// that failed with a server error (5xx). Delays between attempts grow
+// exponentially from BaseDelay, are capped at MaxDelay, and are jittered so
+// that many workers sharing an account don't retry in lockstep. When the
+// response carries a Retry-After header, that value is used instead.
+//
+// Throttled requests are retried for every method, since Harvest rejected
+// them without processing them. Server errors are only retried for GET,
+// HEAD, OPTIONS, PUT, PATCH, and DELETE requests: a POST that fails with a
+// 502 or 504 may already have been committed by Harvest, and retrying it
+// could create a duplicate invoice, payment, or expense.
+type RetryPolicy struct {
+	// The maximum number of attempts for a single request, including the
+	// first. A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// The delay before the first retry. Each subsequent retry doubles it.
+	BaseDelay time.Duration
+
+	// The upper bound for a computed backoff delay. Zero means no cap. A
+	// Retry-After header from Harvest is always honored, even if it
+	// exceeds this value.
+	MaxDelay time.Duration
+}
+
+// The RetryPolicy used by clients created with NewClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// Returns the built-in Middleware that retries throttled and server error
+// responses according to the policy, replaying the request body on each
+// attempt. Every Client includes it, configured from Client.Retry.
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			for attempt := 1; ; attempt++ {
+				attemptReq, err := cloneRequest(req)
+				if err != nil {
+					return nil, err
+				}
+				res, err := next(attemptReq.WithContext(withAttempt(ctx, attempt)))
+				if err != nil {
+					return res, err
+				}
+				if attempt >= policy.MaxAttempts || !shouldRetry(req.Method, res.StatusCode) {
+					return res, nil
+				}
+				delay := policy.delay(attempt, res)
+				drainBody(res)
+				if err := sleepContext(ctx, delay); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+type attemptKey struct{}
+
+// Records the retry attempt number, starting at 1, on a request context.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// Returns the retry attempt number, starting at 1, of a request passing
+// through the middleware chain. Useful for logging and metrics
+// middlewares. Returns 1 if the request didn't pass through the retry
+// middleware.
+func AttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+// Whether a response with the given status code to a request with the
+// given method should be retried. Server errors are only retried for
+// idempotent methods; see RetryPolicy.
+func shouldRetry(method string, statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode >= 500 && isIdempotent(method)
+}
+
+// Whether repeating a request with the given method has the same effect
+// as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Computes how long to wait before the given retry attempt (starting at
+// 1), preferring the response's Retry-After header when present.
+func (p RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+		return d
+	}
+	backoff := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		if p.MaxDelay > 0 && backoff >= p.MaxDelay {
+			break
+		}
+		// Stop doubling before the duration overflows
+		if backoff > math.MaxInt64/2 {
+			break
+		}
+		backoff *= 2
+	}
+	if p.MaxDelay > 0 && backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Jitter between half and all of the computed backoff
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// Parses a Retry-After header, which may hold either a number of seconds
+// or an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		d := time.Until(at)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// Waits for the given duration, or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Discards and closes a response body so the underlying connection can be
+// reused by the next attempt.
+func drainBody(res *http.Response) {
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+}
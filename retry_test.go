@@ -0,0 +1,180 @@
+package goharvest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelayGrowsWithoutMaxDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 40 * time.Millisecond}
+	res := &http.Response{Header: http.Header{}}
+	for attempt := 1; attempt <= 4; attempt++ {
+		backoff := p.BaseDelay << (attempt - 1)
+		for i := 0; i < 20; i++ {
+			d := p.delay(attempt, res)
+			if d < backoff/2 || d > backoff {
+				t.Fatalf("attempt %d: delay %v outside [%v, %v]", attempt, d, backoff/2, backoff)
+			}
+		}
+	}
+}
+
+func TestRetryPolicyDelayCappedAtMaxDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: 40 * time.Millisecond, MaxDelay: 100 * time.Millisecond}
+	res := &http.Response{Header: http.Header{}}
+	for i := 0; i < 20; i++ {
+		if d := p.delay(8, res); d < 50*time.Millisecond || d > 100*time.Millisecond {
+			t.Fatalf("delay %v outside [50ms, 100ms]", d)
+		}
+	}
+}
+
+func TestRetryPolicyDelayDoesNotOverflow(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 200, BaseDelay: time.Hour}
+	res := &http.Response{Header: http.Header{}}
+	if d := p.delay(150, res); d <= 0 {
+		t.Fatalf("delay overflowed to %v", d)
+	}
+}
+
+func TestRetryPolicyDelayHonorsRetryAfter(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if d := p.delay(1, res); d != 7*time.Second {
+		t.Fatalf("got %v, want 7s", d)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{"GET", 429, true},
+		{"POST", 429, true},
+		{"GET", 503, true},
+		{"PATCH", 502, true},
+		{"DELETE", 504, true},
+		{"POST", 502, false},
+		{"POST", 504, false},
+		{"GET", 404, false},
+		{"GET", 200, false},
+	}
+	for _, tt := range tests {
+		if got := shouldRetry(tt.method, tt.status); got != tt.want {
+			t.Errorf("shouldRetry(%s, %d) = %v, want %v", tt.method, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestRetryMiddlewareReplaysBody(t *testing.T) {
+	var attempts atomic.Int32
+	var bodies []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	res, err := c.Patch("/v2/clients/1", map[string]string{"name": "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if attempts.Load() != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts.Load())
+	}
+	for _, b := range bodies {
+		if b != bodies[0] || b == "" {
+			t.Fatalf("bodies not replayed: %q", bodies)
+		}
+	}
+}
+
+func TestRetryMiddlewareStopsAtMaxAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := c.Get("/v2/clients")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := attempts.Load(); got != int32(c.Retry.MaxAttempts) {
+		t.Fatalf("got %d attempts, want %d", got, c.Retry.MaxAttempts)
+	}
+}
+
+func TestRetryMiddlewareDoesNotRetryPostServerError(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.Post("/v2/invoices/1/payments", map[string]float64{"amount": 10})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("got %v, want a 502 APIError", err)
+	}
+	if attempts.Load() != 1 {
+		t.Fatalf("got %d attempts, want 1", attempts.Load())
+	}
+}
+
+func TestRetryMiddlewareRetriesThrottledPost(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	res, err := c.Post("/v2/clients", map[string]string{"name": "Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if attempts.Load() != 2 {
+		t.Fatalf("got %d attempts, want 2", attempts.Load())
+	}
+}
+
+func TestAttemptFromContext(t *testing.T) {
+	var attempts atomic.Int32
+	var seen []int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	c.Use(RequestInterceptor(func(req *http.Request) error {
+		seen = append(seen, AttemptFromContext(req.Context()))
+		return nil
+	}))
+
+	res, err := c.Get("/v2/clients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if len(seen) != 3 || seen[0] != 1 || seen[1] != 2 || seen[2] != 3 {
+		t.Fatalf("got attempts %v, want [1 2 3]", seen)
+	}
+}
@@ -0,0 +1,97 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Requests a single page of the list endpoint at urlTail and decodes the
+// records under key (i.e. "time_entries") one at a time, calling fn with
+// each as soon as it is decoded. Only one record is held in memory at a
+// time, regardless of the page size. The pagination properties of the
+// response are returned once the body has been read, so that the caller
+// can request the next page.
+//
+// If fn returns an error, decoding stops and that error is returned.
+func StreamList[T any](ctx context.Context, c *Client, urlTail string, key string, fn func(T) error) (Pagination, error) {
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return Pagination{}, err
+	}
+	defer res.Body.Close()
+	return decodeListStream(res.Body, key, fn)
+}
+
+// Decodes a list response object from r, streaming the elements of the
+// array under key to fn and collecting every other property into the
+// returned Pagination.
+func decodeListStream[T any](r io.Reader, key string, fn func(T) error) (Pagination, error) {
+	p := Pagination{}
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return p, err
+	}
+
+	rest := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return p, err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return p, fmt.Errorf("goharvest: unexpected token %v in list response", tok)
+		}
+		if name != key {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return p, err
+			}
+			rest[name] = raw
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return p, err
+		}
+		for dec.More() {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return p, err
+			}
+			if err := fn(item); err != nil {
+				return p, err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return p, err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return p, err
+	}
+
+	ba, err := json.Marshal(rest)
+	if err != nil {
+		return p, err
+	}
+	err = json.Unmarshal(ba, &p)
+	if err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// Reads the next token from dec, failing unless it is the given delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("goharvest: expected %v in list response, got %v", delim, tok)
+	}
+	return nil
+}
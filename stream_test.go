@@ -0,0 +1,91 @@
+package goharvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDecodeListStream(t *testing.T) {
+	// The pagination properties come both before and after the records
+	body := `{"page":2,"time_entries":[{"id":1,"notes":"a"},{"id":2,"notes":"b"}],"per_page":2,"total_pages":3,"links":{"next":"https://api.harvestapp.com/v2/time_entries?page=3","previous":null}}`
+	notes := []string{}
+	p, err := decodeListStream(strings.NewReader(body), "time_entries", func(te TimeEntry) error {
+		notes = append(notes, te.Notes)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(notes, ",") != "a,b" {
+		t.Fatalf("got records %v", notes)
+	}
+	if p.Page != 2 || p.PerPage != 2 || p.TotalPages != 3 || p.Links.Next == nil || p.Links.Previous != nil {
+		t.Fatalf("unexpected pagination %+v", p)
+	}
+}
+
+func TestDecodeListStreamEmptyList(t *testing.T) {
+	calls := 0
+	p, err := decodeListStream(strings.NewReader(`{"time_entries":[],"total_pages":1}`), "time_entries", func(TimeEntry) error {
+		calls++
+		return nil
+	})
+	if err != nil || calls != 0 || p.TotalPages != 1 {
+		t.Fatalf("got %d calls, %+v, %v", calls, p, err)
+	}
+}
+
+func TestDecodeListStreamStopsOnCallbackError(t *testing.T) {
+	stop := errors.New("stop")
+	calls := 0
+	_, err := decodeListStream(strings.NewReader(`{"time_entries":[{"id":1},{"id":2},{"id":3}]}`), "time_entries", func(TimeEntry) error {
+		calls++
+		if calls == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || calls != 2 {
+		t.Fatalf("got %d calls and %v, want 2 calls and the callback's error", calls, err)
+	}
+}
+
+func TestDecodeListStreamMalformed(t *testing.T) {
+	bodies := []string{
+		`[]`,
+		`{"time_entries":{}}`,
+		`{"time_entries":[{"id":1}`,
+		`{"time_entries":[{"id":"one"}]}`,
+	}
+	for _, body := range bodies {
+		_, err := decodeListStream(strings.NewReader(body), "time_entries", func(TimeEntry) error { return nil })
+		if err == nil {
+			t.Errorf("%s: expected an error", body)
+		}
+	}
+}
+
+func TestStreamList(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/clients" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"clients":[{"id":1},{"id":2}],"total_entries":2}`))
+	})
+
+	ids := []int{}
+	p, err := StreamList(context.Background(), c, "/v2/clients", "clients", func(cl ClientResource) error {
+		ids = append(ids, cl.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 || p.TotalEntries != 2 {
+		t.Fatalf("got %v and %+v", ids, p)
+	}
+}
@@ -0,0 +1,233 @@
+package goharvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting tasks
+type TaskResponse struct {
+	Tasks []Task `json:"tasks"`
+	Pagination
+}
+
+func (tr TaskResponse) Items() []Task {
+	return tr.Tasks
+}
+
+type Task struct {
+	// Unique ID for the task.
+	ID int `json:"id"`
+
+	// The name of the task.
+	Name string `json:"name"`
+
+	// Used in determining whether default tasks should be marked billable
+	// when creating a new project.
+	BillableByDefault bool `json:"billable_by_default"`
+
+	// The hourly rate to use for this task when it is added to a project.
+	DefaultHourlyRate *float64 `json:"default_hourly_rate"`
+
+	// Whether this task should be automatically added to future projects.
+	IsDefault bool `json:"is_default"`
+
+	// Whether this task is active or archived.
+	IsActive bool `json:"is_active"`
+
+	// Date and time the task was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Date and time the task was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetTaskParameters struct {
+	// Pass true to only return active tasks and false to return
+	// inactive tasks.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return tasks that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your tasks. The tasks are returned sorted by creation
+// date, with the most recently created tasks appearing first.
+func (c *Client) GetTasks(params GetTaskParameters) (TaskResponse, error) {
+	return c.GetTasksWithContext(context.Background(), params)
+}
+
+// GetTasks, bound to the provided context.
+func (c *Client) GetTasksWithContext(ctx context.Context, params GetTaskParameters) (TaskResponse, error) {
+	tr := TaskResponse{}
+	urlTail, err := buildPathWithParams[GetTaskParameters]("/v2/tasks", params)
+	if err != nil {
+		return tr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return tr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&tr)
+	if err != nil {
+		return tr, err
+	}
+	return tr, nil
+}
+
+// Returns an iterator over every task matching the parameters, requesting
+// each page as it is needed. See Iterate.
+func (c *Client) IterTasks(ctx context.Context, params GetTaskParameters) iter.Seq2[Task, error] {
+	urlTail, err := buildPathWithParams[GetTaskParameters]("/v2/tasks", params)
+	if err != nil {
+		return errorSeq[Task](err)
+	}
+	return Iterate[Task, TaskResponse](ctx, c, urlTail)
+}
+
+// Retrieves the task with the given ID. Returns a task object and a 200 OK
+// response code if a valid identifier was provided.
+func (c *Client) GetTask(id int) (Task, error) {
+	return c.GetTaskWithContext(context.Background(), id)
+}
+
+// GetTask, bound to the provided context.
+func (c *Client) GetTaskWithContext(ctx context.Context, id int) (Task, error) {
+	t := Task{}
+	urlTail := fmt.Sprintf("/v2/tasks/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return t, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&t)
+	if err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+type CreateTaskBody struct {
+	// The name of the task. - required
+	Name string `json:"name" url:"name,omitempty"`
+
+	// Used in determining whether default tasks should be marked billable
+	// when creating a new project. Defaults to true. - optional
+	BillableByDefault *bool `json:"billable_by_default,omitempty" url:"billable_by_default,omitempty"`
+
+	// The default hourly rate to use for this task when it is added to a
+	// project. Defaults to 0. - optional
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty" url:"default_hourly_rate,omitempty"`
+
+	// Whether this task should be automatically added to future projects.
+	// Defaults to false. - optional
+	IsDefault *bool `json:"is_default,omitempty" url:"is_default,omitempty"`
+
+	// Whether this task is active or archived. Defaults to true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+}
+
+func (b CreateTaskBody) IsValid() bool {
+	return b.Name != ""
+}
+
+// Creates a new task object. Returns a task object and a 201 Created
+// response code if the call succeeded.
+func (c *Client) CreateTask(body CreateTaskBody) (Task, error) {
+	return c.CreateTaskWithContext(context.Background(), body)
+}
+
+// CreateTask, bound to the provided context.
+func (c *Client) CreateTaskWithContext(ctx context.Context, body CreateTaskBody) (Task, error) {
+	t := Task{}
+	if !body.IsValid() {
+		return t, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/tasks", body)
+	if err != nil {
+		return t, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&t)
+	if err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+type UpdateTaskBody struct {
+	// The name of the task.
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
+
+	// Used in determining whether default tasks should be marked billable
+	// when creating a new project.
+	BillableByDefault *bool `json:"billable_by_default,omitempty" url:"billable_by_default,omitempty"`
+
+	// The default hourly rate to use for this task when it is added to
+	// a project.
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty" url:"default_hourly_rate,omitempty"`
+
+	// Whether this task should be automatically added to future projects.
+	IsDefault *bool `json:"is_default,omitempty" url:"is_default,omitempty"`
+
+	// Whether this task is active or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+}
+
+func (b UpdateTaskBody) IsValid() bool {
+	return b.Name == nil || *b.Name != ""
+}
+
+// Updates the specific task by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns a
+// task object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateTask(id int, body UpdateTaskBody) (Task, error) {
+	return c.UpdateTaskWithContext(context.Background(), id, body)
+}
+
+// UpdateTask, bound to the provided context.
+func (c *Client) UpdateTaskWithContext(ctx context.Context, id int, body UpdateTaskBody) (Task, error) {
+	t := Task{}
+	if !body.IsValid() {
+		return t, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/tasks/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return t, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&t)
+	if err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// Delete a task. Deleting a task is only possible if it has no time
+// entries associated with it. Returns a 200 OK response code if the call
+// succeeded.
+func (c *Client) DeleteTask(id int) error {
+	return c.DeleteTaskWithContext(context.Background(), id)
+}
+
+// DeleteTask, bound to the provided context.
+func (c *Client) DeleteTaskWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/tasks/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
@@ -1,6 +1,23 @@
 package goharvest
 
-import "time"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting task assignments
+type TaskAssignmentResponse struct {
+	TaskAssignments []TaskAssignment `json:"task_assignments"`
+	Pagination
+}
+
+func (tr TaskAssignmentResponse) Items() []TaskAssignment {
+	return tr.TaskAssignments
+}
 
 type TaskAssignmentProject struct {
 	ID   int    `json:"id"`
@@ -41,3 +58,216 @@ type TaskAssignment struct {
 	// Date and time the task assignment was last updated.
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type GetTaskAssignmentParameters struct {
+	// Pass true to only return active task assignments and false to return
+	// inactive task assignments.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return task assignments that have been updated since the given
+	// date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your task assignments across all projects. The task
+// assignments are returned sorted by creation date, with the most recently
+// created task assignments appearing first.
+func (c *Client) GetTaskAssignments(params GetTaskAssignmentParameters) (TaskAssignmentResponse, error) {
+	return c.GetTaskAssignmentsWithContext(context.Background(), params)
+}
+
+// GetTaskAssignments, bound to the provided context.
+func (c *Client) GetTaskAssignmentsWithContext(ctx context.Context, params GetTaskAssignmentParameters) (TaskAssignmentResponse, error) {
+	return c.getTaskAssignments(ctx, "/v2/task_assignments", params)
+}
+
+// Returns an iterator over every task assignment matching the parameters,
+// across all projects, requesting each page as it is needed. See Iterate.
+func (c *Client) IterTaskAssignments(ctx context.Context, params GetTaskAssignmentParameters) iter.Seq2[TaskAssignment, error] {
+	urlTail, err := buildPathWithParams[GetTaskAssignmentParameters]("/v2/task_assignments", params)
+	if err != nil {
+		return errorSeq[TaskAssignment](err)
+	}
+	return Iterate[TaskAssignment, TaskAssignmentResponse](ctx, c, urlTail)
+}
+
+// Returns a list of the task assignments for the project with the given
+// ID. The task assignments are returned sorted by creation date, with the
+// most recently created task assignments appearing first.
+func (c *Client) GetProjectTaskAssignments(projectID int, params GetTaskAssignmentParameters) (TaskAssignmentResponse, error) {
+	return c.GetProjectTaskAssignmentsWithContext(context.Background(), projectID, params)
+}
+
+// GetProjectTaskAssignments, bound to the provided context.
+func (c *Client) GetProjectTaskAssignmentsWithContext(ctx context.Context, projectID int, params GetTaskAssignmentParameters) (TaskAssignmentResponse, error) {
+	return c.getTaskAssignments(ctx, fmt.Sprintf("/v2/projects/%d/task_assignments", projectID), params)
+}
+
+// Returns an iterator over every task assignment for the project with the
+// given ID, requesting each page as it is needed. See Iterate.
+func (c *Client) IterProjectTaskAssignments(ctx context.Context, projectID int, params GetTaskAssignmentParameters) iter.Seq2[TaskAssignment, error] {
+	urlTail, err := buildPathWithParams[GetTaskAssignmentParameters](fmt.Sprintf("/v2/projects/%d/task_assignments", projectID), params)
+	if err != nil {
+		return errorSeq[TaskAssignment](err)
+	}
+	return Iterate[TaskAssignment, TaskAssignmentResponse](ctx, c, urlTail)
+}
+
+func (c *Client) getTaskAssignments(ctx context.Context, path string, params GetTaskAssignmentParameters) (TaskAssignmentResponse, error) {
+	tr := TaskAssignmentResponse{}
+	urlTail, err := buildPathWithParams[GetTaskAssignmentParameters](path, params)
+	if err != nil {
+		return tr, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return tr, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&tr)
+	if err != nil {
+		return tr, err
+	}
+	return tr, nil
+}
+
+// Retrieves the task assignment with the given ID on the given project.
+// Returns a task assignment object and a 200 OK response code if a valid
+// identifier was provided.
+func (c *Client) GetTaskAssignment(projectID int, id int) (TaskAssignment, error) {
+	return c.GetTaskAssignmentWithContext(context.Background(), projectID, id)
+}
+
+// GetTaskAssignment, bound to the provided context.
+func (c *Client) GetTaskAssignmentWithContext(ctx context.Context, projectID int, id int) (TaskAssignment, error) {
+	ta := TaskAssignment{}
+	urlTail := fmt.Sprintf("/v2/projects/%d/task_assignments/%d", projectID, id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ta, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ta)
+	if err != nil {
+		return ta, err
+	}
+	return ta, nil
+}
+
+type CreateTaskAssignmentBody struct {
+	// The ID of the task to associate with the project. - required
+	TaskID int `json:"task_id" url:"task_id,omitempty"`
+
+	// Whether the task assignment is active or archived. Defaults to
+	// true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Whether the task assignment is billable or not. Defaults to
+	// false. - optional
+	Billable *bool `json:"billable,omitempty" url:"billable,omitempty"`
+
+	// Rate used when the project’s bill_by is Tasks. Defaults to null when
+	// billing by task hourly rate, otherwise 0. - optional
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// Budget used when the project’s budget_by is task or
+	// task_fees. - optional
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+}
+
+func (b CreateTaskAssignmentBody) IsValid() bool {
+	return b.TaskID != 0
+}
+
+// Creates a new task assignment object on the given project. Returns a
+// task assignment object and a 201 Created response code if the call
+// succeeded.
+func (c *Client) CreateTaskAssignment(projectID int, body CreateTaskAssignmentBody) (TaskAssignment, error) {
+	return c.CreateTaskAssignmentWithContext(context.Background(), projectID, body)
+}
+
+// CreateTaskAssignment, bound to the provided context.
+func (c *Client) CreateTaskAssignmentWithContext(ctx context.Context, projectID int, body CreateTaskAssignmentBody) (TaskAssignment, error) {
+	ta := TaskAssignment{}
+	if !body.IsValid() {
+		return ta, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/projects/%d/task_assignments", projectID)
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ta, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ta)
+	if err != nil {
+		return ta, err
+	}
+	return ta, nil
+}
+
+type UpdateTaskAssignmentBody struct {
+	// Whether the task assignment is active or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Whether the task assignment is billable or not.
+	Billable *bool `json:"billable,omitempty" url:"billable,omitempty"`
+
+	// Rate used when the project’s bill_by is Tasks.
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// Budget used when the project’s budget_by is task or task_fees.
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+}
+
+func (b UpdateTaskAssignmentBody) IsValid() bool {
+	return true
+}
+
+// Updates the specific task assignment by setting the values of the
+// parameters passed. Any parameters not provided will be left unchanged.
+// Returns a task assignment object and a 200 OK response code if the call
+// succeeded.
+func (c *Client) UpdateTaskAssignment(projectID int, id int, body UpdateTaskAssignmentBody) (TaskAssignment, error) {
+	return c.UpdateTaskAssignmentWithContext(context.Background(), projectID, id, body)
+}
+
+// UpdateTaskAssignment, bound to the provided context.
+func (c *Client) UpdateTaskAssignmentWithContext(ctx context.Context, projectID int, id int, body UpdateTaskAssignmentBody) (TaskAssignment, error) {
+	ta := TaskAssignment{}
+	if !body.IsValid() {
+		return ta, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/projects/%d/task_assignments/%d", projectID, id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ta, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ta)
+	if err != nil {
+		return ta, err
+	}
+	return ta, nil
+}
+
+// Delete a task assignment. Deleting a task assignment is only possible if
+// it has no time entries associated with it. Returns a 200 OK response
+// code if the call succeeded.
+func (c *Client) DeleteTaskAssignment(projectID int, id int) error {
+	return c.DeleteTaskAssignmentWithContext(context.Background(), projectID, id)
+}
+
+// DeleteTaskAssignment, bound to the provided context.
+func (c *Client) DeleteTaskAssignmentWithContext(ctx context.Context, projectID int, id int) error {
+	urlTail := fmt.Sprintf("/v2/projects/%d/task_assignments/%d", projectID, id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
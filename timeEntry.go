@@ -1,9 +1,11 @@
 package goharvest
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -13,6 +15,10 @@ type TimeEntryResponse struct {
 	Pagination
 }
 
+func (tr TimeEntryResponse) Items() []TimeEntry {
+	return tr.TimeEntries
+}
+
 // A time entry
 type TimeEntry struct {
 	// Unique ID for the time entry. Listed as 'bigint' in documentation
@@ -266,15 +272,21 @@ func (b CreateTimeEntryBodyDuration) IsValid() bool {
 // Returns a list of time entries. The time entries are returned sorted by
 // spent_date date. At this time, the sort option can’t be customized.
 func (c *Client) GetTimeEntries(params GetTimeEntryParameters) (TimeEntryResponse, error) {
+	return c.GetTimeEntriesWithContext(context.Background(), params)
+}
+
+// GetTimeEntries, bound to the provided context.
+func (c *Client) GetTimeEntriesWithContext(ctx context.Context, params GetTimeEntryParameters) (TimeEntryResponse, error) {
 	tr := TimeEntryResponse{}
 	urlTail, err := buildPathWithParams[GetTimeEntryParameters]("/v2/time_entries", params)
 	if err != nil {
 		return tr, err
 	}
-	res, err := c.Get(urlTail)
+	res, err := c.GetWithContext(ctx, urlTail)
 	if err != nil {
 		return tr, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&tr)
 	if err != nil {
 		return tr, err
@@ -282,15 +294,53 @@ func (c *Client) GetTimeEntries(params GetTimeEntryParameters) (TimeEntryRespons
 	return tr, nil
 }
 
+// Like GetTimeEntries, but rather than decoding the whole page into
+// memory, calls fn with each time entry as it is decoded from the
+// response. Returns the page's pagination properties. See StreamList.
+func (c *Client) StreamTimeEntries(ctx context.Context, params GetTimeEntryParameters, fn func(TimeEntry) error) (Pagination, error) {
+	urlTail, err := buildPathWithParams[GetTimeEntryParameters]("/v2/time_entries", params)
+	if err != nil {
+		return Pagination{}, err
+	}
+	return StreamList(ctx, c, urlTail, "time_entries", fn)
+}
+
+// Returns an iterator over every time entry matching the parameters,
+// requesting each page as it is needed. See Iterate.
+func (c *Client) IterTimeEntries(ctx context.Context, params GetTimeEntryParameters) iter.Seq2[TimeEntry, error] {
+	urlTail, err := buildPathWithParams[GetTimeEntryParameters]("/v2/time_entries", params)
+	if err != nil {
+		return errorSeq[TimeEntry](err)
+	}
+	return Iterate[TimeEntry, TimeEntryResponse](ctx, c, urlTail)
+}
+
+// Like IterTimeEntries, but fetches the remaining pages in parallel with
+// up to workers goroutines once the first page has been read. Time entries
+// are still yielded in order. See IterateConcurrent.
+func (c *Client) IterTimeEntriesConcurrent(ctx context.Context, params GetTimeEntryParameters, workers int) iter.Seq2[TimeEntry, error] {
+	urlTail, err := buildPathWithParams[GetTimeEntryParameters]("/v2/time_entries", params)
+	if err != nil {
+		return errorSeq[TimeEntry](err)
+	}
+	return IterateConcurrent[TimeEntry, TimeEntryResponse](ctx, c, urlTail, workers)
+}
+
 // Retrieves the time entry with the given ID. Returns a time entry object
 // and a 200 OK response code if a valid identifier was provided.
 func (c *Client) GetTimeEntry(id uint64) (TimeEntry, error) {
+	return c.GetTimeEntryWithContext(context.Background(), id)
+}
+
+// GetTimeEntry, bound to the provided context.
+func (c *Client) GetTimeEntryWithContext(ctx context.Context, id uint64) (TimeEntry, error) {
 	te := TimeEntry{}
 	urlTail := fmt.Sprintf("/v2/time_entries/%d", id)
-	res, err := c.Get(urlTail)
+	res, err := c.GetWithContext(ctx, urlTail)
 	if err != nil {
 		return te, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&te)
 	if err != nil {
 		return te, err
@@ -312,13 +362,19 @@ func (c *Client) GetTimeEntry(id uint64) (TimeEntry, error) {
 // type opposite the type expected according to the settings - then this
 // endpoint will create a running timer.
 func (c *Client) CreateTimeEntry(body CreateTimeEntryBody) (TimeEntry, error) {
+	return c.CreateTimeEntryWithContext(context.Background(), body)
+}
+
+// CreateTimeEntry, bound to the provided context.
+func (c *Client) CreateTimeEntryWithContext(ctx context.Context, body CreateTimeEntryBody) (TimeEntry, error) {
 	te := TimeEntry{}
 	if body.IsValid() {
 		urlTail := "/v2/time_entries"
-		res, err := c.Post(urlTail, body)
+		res, err := c.PostWithContext(ctx, urlTail, body)
 		if err != nil {
 			return te, err
 		}
+		defer res.Body.Close()
 		err = json.NewDecoder(res.Body).Decode(&te)
 		if err != nil {
 			return te, err
@@ -368,13 +424,19 @@ func (b UpdateTimeEntryBody) IsValid() bool {
 // passed. Any parameters not provided will be left unchanged. Returns a
 // time entry object and a 200 OK response code if the call succeeded.
 func (c *Client) UpdateTimeEntry(timeEntryId uint64, body UpdateTimeEntryBody) (TimeEntry, error) {
+	return c.UpdateTimeEntryWithContext(context.Background(), timeEntryId, body)
+}
+
+// UpdateTimeEntry, bound to the provided context.
+func (c *Client) UpdateTimeEntryWithContext(ctx context.Context, timeEntryId uint64, body UpdateTimeEntryBody) (TimeEntry, error) {
 	te := TimeEntry{}
 	if body.IsValid() {
 		urlTail := fmt.Sprintf("/v2/time_entries/%d", timeEntryId)
-		res, err := c.Patch(urlTail, body)
+		res, err := c.PatchWithContext(ctx, urlTail, body)
 		if err != nil {
 			return te, err
 		}
+		defer res.Body.Close()
 		err = json.NewDecoder(res.Body).Decode(&te)
 		if err != nil {
 			return te, err
@@ -390,20 +452,30 @@ func (c *Client) UpdateTimeEntry(timeEntryId uint64, body UpdateTimeEntryBody) (
 // However, Admins can delete closed entries. Returns a 200 OK response
 // code if the call succeeded.
 func (c *Client) DeleteTimeEntry(id uint64) error {
-	urlTail := fmt.Sprintf("/v2/time_entries/%d", id)
-	return c.Delete(urlTail)
+	return c.DeleteTimeEntryWithContext(context.Background(), id)
+}
 
+// DeleteTimeEntry, bound to the provided context.
+func (c *Client) DeleteTimeEntryWithContext(ctx context.Context, id uint64) error {
+	urlTail := fmt.Sprintf("/v2/time_entries/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
 }
 
 // Restarting a time entry is only possible if it isn’t currently running.
 // Returns a 200 OK response code if the call succeeded.
 func (c *Client) RestartTimeEntryTimer(id uint64) (TimeEntry, error) {
+	return c.RestartTimeEntryTimerWithContext(context.Background(), id)
+}
+
+// RestartTimeEntryTimer, bound to the provided context.
+func (c *Client) RestartTimeEntryTimerWithContext(ctx context.Context, id uint64) (TimeEntry, error) {
 	te := TimeEntry{}
 	urlTail := fmt.Sprintf("/v2/time_entries/%d/restart", id)
-	res, err := c.Patch(urlTail, nil)
+	res, err := c.PatchWithContext(ctx, urlTail, nil)
 	if err != nil {
 		return te, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&te)
 	if err != nil {
 		return te, err
@@ -414,12 +486,18 @@ func (c *Client) RestartTimeEntryTimer(id uint64) (TimeEntry, error) {
 // Stopping a time entry is only possible if it’s currently running.
 // Returns a 200 OK response code if the call succeeded.
 func (c *Client) StopTimeEntryTimer(id uint64) (TimeEntry, error) {
+	return c.StopTimeEntryTimerWithContext(context.Background(), id)
+}
+
+// StopTimeEntryTimer, bound to the provided context.
+func (c *Client) StopTimeEntryTimerWithContext(ctx context.Context, id uint64) (TimeEntry, error) {
 	te := TimeEntry{}
 	urlTail := fmt.Sprintf("/v2/time_entries/%d/stop", id)
-	res, err := c.Patch(urlTail, nil)
+	res, err := c.PatchWithContext(ctx, urlTail, nil)
 	if err != nil {
 		return te, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&te)
 	if err != nil {
 		return te, err
@@ -1,10 +1,40 @@
 package goharvest
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
 	"time"
 )
 
+// The access roles that determine a user's permissions in Harvest. Every
+// user has exactly one of administrator, manager, or member; managers can
+// additionally be granted any of the remaining roles.
+const (
+	AccessRoleAdministrator                 = "administrator"
+	AccessRoleManager                       = "manager"
+	AccessRoleMember                        = "member"
+	AccessRoleProjectCreator                = "project_creator"
+	AccessRoleBillableRatesManager          = "billable_rates_manager"
+	AccessRoleManagedProjectsInvoiceDrafter = "managed_projects_invoice_drafter"
+	AccessRoleManagedProjectsInvoiceManager = "managed_projects_invoice_manager"
+	AccessRoleClientAndTaskManager          = "client_and_task_manager"
+	AccessRoleTimeAndExpensesManager        = "time_and_expenses_manager"
+	AccessRoleEstimatesManager              = "estimates_manager"
+)
+
+// A response object from requesting users
+type UserResponse struct {
+	Users []User `json:"users"`
+	Pagination
+}
+
+func (ur UserResponse) Items() []User {
+	return ur.Users
+}
+
 type User struct {
 	// Unique ID for the user.
 	ID int `json:"id"`
@@ -51,15 +81,15 @@ type User struct {
 	// permissions in Harvest.
 	Roles []string `json:"roles"`
 
-	//help.getharvest.com/api-v2/users-api/users/users/#access-roles) that
-	// determine the user’s permissions in Harvest. Possible values:
-	// `administrator`, `manager` or `member`. Users with the manager role
-	// can additionally be granted one or more of these roles:
-	// `project_creator`, `billable_rates_manager`,
-	// `managed_projects_invoice_drafter`,
+	// Access role(s) that determine the user’s permissions in Harvest. See
+	// https://help.getharvest.com/api-v2/users-api/users/users/#access-roles
+	// and the AccessRole constants. Possible values: `administrator`,
+	// `manager` or `member`. Users with the manager role can additionally
+	// be granted one or more of these roles: `project_creator`,
+	// `billable_rates_manager`, `managed_projects_invoice_drafter`,
 	// `managed_projects_invoice_manager`, `client_and_task_manager`,
 	// `time_and_expenses_manager`, `estimates_manager`.
-	AccessRoles []string `json:"access_roles"` // [Access role(s)](https:
+	AccessRoles []string `json:"access_roles"`
 
 	// The URL to the user’s avatar image.
 	AvatarURL string `json:"avatar_url"`
@@ -91,15 +121,38 @@ type GetProjectAssignmentParameters struct {
 // creation date, with the most recently created project assignments
 // appearing first.
 func (c *Client) GetMyProjectAssignments(params GetProjectAssignmentParameters) (ProjectAssignmentResponse, error) {
+	return c.GetMyProjectAssignmentsWithContext(context.Background(), params)
+}
+
+// GetMyProjectAssignments, bound to the provided context.
+func (c *Client) GetMyProjectAssignmentsWithContext(ctx context.Context, params GetProjectAssignmentParameters) (ProjectAssignmentResponse, error) {
+	return c.getProjectAssignments(ctx, "/v2/users/me/project_assignments", params)
+}
+
+// Returns a list of active project assignments for the user with the
+// given ID. The project assignments are returned sorted by creation date,
+// with the most recently created project assignments appearing first.
+// Requires Administrator or Manager permissions.
+func (c *Client) GetUserProjectAssignments(userID int, params GetProjectAssignmentParameters) (ProjectAssignmentResponse, error) {
+	return c.GetUserProjectAssignmentsWithContext(context.Background(), userID, params)
+}
+
+// GetUserProjectAssignments, bound to the provided context.
+func (c *Client) GetUserProjectAssignmentsWithContext(ctx context.Context, userID int, params GetProjectAssignmentParameters) (ProjectAssignmentResponse, error) {
+	return c.getProjectAssignments(ctx, fmt.Sprintf("/v2/users/%d/project_assignments", userID), params)
+}
+
+func (c *Client) getProjectAssignments(ctx context.Context, path string, params GetProjectAssignmentParameters) (ProjectAssignmentResponse, error) {
 	pa := ProjectAssignmentResponse{}
-	urlTail, err := buildPathWithParams[GetProjectAssignmentParameters]("/v2/users/me/project_assignments", params)
+	urlTail, err := buildPathWithParams[GetProjectAssignmentParameters](path, params)
 	if err != nil {
 		return pa, err
 	}
-	res, err := c.Get(urlTail)
+	res, err := c.GetWithContext(ctx, urlTail)
 	if err != nil {
 		return pa, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&pa)
 	if err != nil {
 		return pa, err
@@ -107,18 +160,302 @@ func (c *Client) GetMyProjectAssignments(params GetProjectAssignmentParameters)
 	return pa, nil
 }
 
+// Returns an iterator over every project assignment for the currently
+// authenticated user, requesting each page as it is needed. See Iterate.
+func (c *Client) IterMyProjectAssignments(ctx context.Context, params GetProjectAssignmentParameters) iter.Seq2[ProjectAssignment, error] {
+	urlTail, err := buildPathWithParams[GetProjectAssignmentParameters]("/v2/users/me/project_assignments", params)
+	if err != nil {
+		return errorSeq[ProjectAssignment](err)
+	}
+	return Iterate[ProjectAssignment, ProjectAssignmentResponse](ctx, c, urlTail)
+}
+
+// Returns an iterator over every project assignment for the user with the
+// given ID, requesting each page as it is needed. See Iterate.
+func (c *Client) IterUserProjectAssignments(ctx context.Context, userID int, params GetProjectAssignmentParameters) iter.Seq2[ProjectAssignment, error] {
+	urlTail, err := buildPathWithParams[GetProjectAssignmentParameters](fmt.Sprintf("/v2/users/%d/project_assignments", userID), params)
+	if err != nil {
+		return errorSeq[ProjectAssignment](err)
+	}
+	return Iterate[ProjectAssignment, ProjectAssignmentResponse](ctx, c, urlTail)
+}
+
 // Retrieves the currently authenticated user. Returns a user object and a
 // 200 OK response code.
 func (c *Client) GetMe() (User, error) {
+	return c.GetMeWithContext(context.Background())
+}
+
+// GetMe, bound to the provided context.
+func (c *Client) GetMeWithContext(ctx context.Context) (User, error) {
 	u := User{}
 	urlTail := "/v2/users/me"
-	res, err := c.Get(urlTail)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return u, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&u)
+	if err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
+type GetUserParameters struct {
+	// Pass true to only return active users and false to return
+	// inactive users.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return users that have been updated since the given date
+	// and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your users. The users are returned sorted by creation
+// date, with the most recently created users appearing first.
+func (c *Client) GetUsers(params GetUserParameters) (UserResponse, error) {
+	return c.GetUsersWithContext(context.Background(), params)
+}
+
+// GetUsers, bound to the provided context.
+func (c *Client) GetUsersWithContext(ctx context.Context, params GetUserParameters) (UserResponse, error) {
+	ur := UserResponse{}
+	urlTail, err := buildPathWithParams[GetUserParameters]("/v2/users", params)
+	if err != nil {
+		return ur, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ur, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ur)
+	if err != nil {
+		return ur, err
+	}
+	return ur, nil
+}
+
+// Returns an iterator over every user matching the parameters, requesting
+// each page as it is needed. See Iterate.
+func (c *Client) IterUsers(ctx context.Context, params GetUserParameters) iter.Seq2[User, error] {
+	urlTail, err := buildPathWithParams[GetUserParameters]("/v2/users", params)
+	if err != nil {
+		return errorSeq[User](err)
+	}
+	return Iterate[User, UserResponse](ctx, c, urlTail)
+}
+
+// Retrieves the user with the given ID. Returns a user object and a 200 OK
+// response code if a valid identifier was provided.
+func (c *Client) GetUser(id int) (User, error) {
+	return c.GetUserWithContext(context.Background(), id)
+}
+
+// GetUser, bound to the provided context.
+func (c *Client) GetUserWithContext(ctx context.Context, id int) (User, error) {
+	u := User{}
+	urlTail := fmt.Sprintf("/v2/users/%d", id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return u, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&u)
+	if err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
+type CreateUserBody struct {
+	// The first name of the user. - required
+	FirstName string `json:"first_name" url:"first_name,omitempty"`
+
+	// The last name of the user. - required
+	LastName string `json:"last_name" url:"last_name,omitempty"`
+
+	// The email address of the user. An invitation is sent to this
+	// address. - required
+	Email string `json:"email" url:"email,omitempty"`
+
+	// The user’s timezone. Defaults to the company’s timezone. - optional
+	Timezone string `json:"timezone,omitempty" url:"timezone,omitempty"`
+
+	// Whether the user should be automatically added to future projects.
+	// Defaults to false. - optional
+	HasAccessToAllFutureProjects *bool `json:"has_access_to_all_future_projects,omitempty" url:"has_access_to_all_future_projects,omitempty"`
+
+	// Whether the user is a contractor or an employee. Defaults to
+	// false. - optional
+	IsContractor *bool `json:"is_contractor,omitempty" url:"is_contractor,omitempty"`
+
+	// Whether the user is active or archived. Defaults to true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// The number of hours per week this person is available to work in
+	// seconds. Defaults to 126000 seconds (35 hours). - optional
+	WeeklyCapacity *int `json:"weekly_capacity,omitempty" url:"weekly_capacity,omitempty"`
+
+	// The billable rate to use for this user when they are added to a
+	// project. Defaults to 0. - optional
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty" url:"default_hourly_rate,omitempty"`
+
+	// The cost rate to use for this user when calculating a project’s costs
+	// vs billable amount. Defaults to 0. - optional
+	CostRate *float64 `json:"cost_rate,omitempty" url:"cost_rate,omitempty"`
+
+	// Descriptive names of the business roles assigned to this
+	// person. - optional
+	Roles []string `json:"roles,omitempty" url:"roles,omitempty"`
+
+	// Access role(s) that determine the user’s permissions in Harvest.
+	// Defaults to member. See the AccessRole constants. - optional
+	AccessRoles []string `json:"access_roles,omitempty" url:"access_roles,omitempty"`
+}
+
+func (b CreateUserBody) IsValid() bool {
+	return b.FirstName != "" && b.LastName != "" && b.Email != ""
+}
+
+// Creates a new user object and sends an invitation email to the address
+// specified in the email parameter. Returns a user object and a 201
+// Created response code if the call succeeded.
+func (c *Client) CreateUser(body CreateUserBody) (User, error) {
+	return c.CreateUserWithContext(context.Background(), body)
+}
+
+// CreateUser, bound to the provided context.
+func (c *Client) CreateUserWithContext(ctx context.Context, body CreateUserBody) (User, error) {
+	u := User{}
+	if !body.IsValid() {
+		return u, errors.New("Invalid body")
+	}
+	res, err := c.PostWithContext(ctx, "/v2/users", body)
+	if err != nil {
+		return u, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&u)
+	if err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
+type UpdateUserBody struct {
+	// The first name of the user. Can’t be updated if the user is inactive.
+	FirstName *string `json:"first_name,omitempty" url:"first_name,omitempty"`
+
+	// The last name of the user. Can’t be updated if the user is inactive.
+	LastName *string `json:"last_name,omitempty" url:"last_name,omitempty"`
+
+	// The email address of the user. Can’t be updated if the user
+	// is inactive.
+	Email *string `json:"email,omitempty" url:"email,omitempty"`
+
+	// The user’s timezone.
+	Timezone *string `json:"timezone,omitempty" url:"timezone,omitempty"`
+
+	// Whether the user should be automatically added to future projects.
+	HasAccessToAllFutureProjects *bool `json:"has_access_to_all_future_projects,omitempty" url:"has_access_to_all_future_projects,omitempty"`
+
+	// Whether the user is a contractor or an employee.
+	IsContractor *bool `json:"is_contractor,omitempty" url:"is_contractor,omitempty"`
+
+	// Whether the user is active or archived. See ArchiveUser.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// The number of hours per week this person is available to work
+	// in seconds.
+	WeeklyCapacity *int `json:"weekly_capacity,omitempty" url:"weekly_capacity,omitempty"`
+
+	// The billable rate to use for this user when they are added to
+	// a project.
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty" url:"default_hourly_rate,omitempty"`
+
+	// The cost rate to use for this user when calculating a project’s costs
+	// vs billable amount.
+	CostRate *float64 `json:"cost_rate,omitempty" url:"cost_rate,omitempty"`
+
+	// Descriptive names of the business roles assigned to this person.
+	// Replaces the user’s existing roles; pass an empty slice to clear them.
+	Roles *[]string `json:"roles,omitempty" url:"roles,omitempty"`
+
+	// Access role(s) that determine the user’s permissions in Harvest.
+	// Replaces the user’s existing access roles. See the AccessRole
+	// constants.
+	AccessRoles *[]string `json:"access_roles,omitempty" url:"access_roles,omitempty"`
+}
+
+func (b UpdateUserBody) IsValid() bool {
+	if b.FirstName != nil && *b.FirstName == "" {
+		return false
+	}
+	if b.LastName != nil && *b.LastName == "" {
+		return false
+	}
+	return b.Email == nil || *b.Email != ""
+}
+
+// Updates the specific user by setting the values of the parameters
+// passed. Any parameters not provided will be left unchanged. Returns a
+// user object and a 200 OK response code if the call succeeded.
+func (c *Client) UpdateUser(id int, body UpdateUserBody) (User, error) {
+	return c.UpdateUserWithContext(context.Background(), id, body)
+}
+
+// UpdateUser, bound to the provided context.
+func (c *Client) UpdateUserWithContext(ctx context.Context, id int, body UpdateUserBody) (User, error) {
+	u := User{}
+	if !body.IsValid() {
+		return u, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/users/%d", id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
 	if err != nil {
 		return u, err
 	}
+	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&u)
 	if err != nil {
 		return u, err
 	}
 	return u, nil
 }
+
+// Archives the user with the given ID by marking them inactive. Unlike
+// deleting, archiving is possible for users with time entries or
+// expenses. Returns the updated user.
+func (c *Client) ArchiveUser(id int) (User, error) {
+	return c.ArchiveUserWithContext(context.Background(), id)
+}
+
+// ArchiveUser, bound to the provided context.
+func (c *Client) ArchiveUserWithContext(ctx context.Context, id int) (User, error) {
+	isActive := false
+	return c.UpdateUserWithContext(ctx, id, UpdateUserBody{IsActive: &isActive})
+}
+
+// Delete a user. Deleting a user is only possible if they have no time
+// entries or expenses associated with them; archive them with ArchiveUser
+// instead. Returns a 200 OK response code if the call succeeded.
+func (c *Client) DeleteUser(id int) error {
+	return c.DeleteUserWithContext(context.Background(), id)
+}
+
+// DeleteUser, bound to the provided context.
+func (c *Client) DeleteUserWithContext(ctx context.Context, id int) error {
+	urlTail := fmt.Sprintf("/v2/users/%d", id)
+	return c.DeleteWithContext(ctx, urlTail)
+}
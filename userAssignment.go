@@ -1,23 +1,44 @@
 package goharvest
 
-import "time"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A response object from requesting user assignments
+type UserAssignmentResponse struct {
+	UserAssignments []UserAssignment `json:"user_assignments"`
+	Pagination
+}
+
+func (ur UserAssignmentResponse) Items() []UserAssignment {
+	return ur.UserAssignments
+}
+
+type UserAssignmentProject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+type UserAssignmentUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
 
 type UserAssignment struct {
 	// Unique ID for the user assignment.
 	ID int `json:"id"`
 
 	// An object containing the id, name, and code of the associated project.
-	Project struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-		Code string `json:"code"`
-	} `json:"project"`
+	Project UserAssignmentProject `json:"project"`
 
 	// An object containing the id and name of the associated user.
-	User struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-	} `json:"user"`
+	User UserAssignmentUser `json:"user"`
 
 	// Whether the user assignment is active or archived.
 	IsActive bool `json:"is_active"`
@@ -44,3 +65,233 @@ type UserAssignment struct {
 	// Date and time the user assignment was last updated.
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type GetUserAssignmentParameters struct {
+	// Only return user assignments belonging to the user with the given ID.
+	UserID int `json:"user_id" url:"user_id,omitempty"`
+
+	// Pass true to only return active user assignments and false to return
+	// inactive user assignments.
+	IsActive *bool `json:"is_active" url:"is_active,omitempty"`
+
+	// Only return user assignments that have been updated since the given
+	// date and time.
+	UpdatedSince time.Time `json:"updated_since" url:"updated_since,omitempty"`
+
+	// DEPRECATED The page number to use in pagination. For instance, if you
+	// make a list request and receive 2000 records, your subsequent call can
+	// include page=2 to retrieve the next page of the list. (Default: 1)
+	Page int `json:"page" url:"page,omitempty"`
+
+	// The number of records to return per page. Can range between 1 and
+	// 2000. (Default: 2000)
+	PerPage int `json:"per_page" url:"per_page,omitempty"`
+}
+
+// Returns a list of your user assignments across all projects. The user
+// assignments are returned sorted by creation date, with the most recently
+// created user assignments appearing first.
+func (c *Client) GetUserAssignments(params GetUserAssignmentParameters) (UserAssignmentResponse, error) {
+	return c.GetUserAssignmentsWithContext(context.Background(), params)
+}
+
+// GetUserAssignments, bound to the provided context.
+func (c *Client) GetUserAssignmentsWithContext(ctx context.Context, params GetUserAssignmentParameters) (UserAssignmentResponse, error) {
+	return c.getUserAssignments(ctx, "/v2/user_assignments", params)
+}
+
+// Returns an iterator over every user assignment matching the parameters,
+// across all projects, requesting each page as it is needed. See Iterate.
+func (c *Client) IterUserAssignments(ctx context.Context, params GetUserAssignmentParameters) iter.Seq2[UserAssignment, error] {
+	urlTail, err := buildPathWithParams[GetUserAssignmentParameters]("/v2/user_assignments", params)
+	if err != nil {
+		return errorSeq[UserAssignment](err)
+	}
+	return Iterate[UserAssignment, UserAssignmentResponse](ctx, c, urlTail)
+}
+
+// Returns a list of the user assignments for the project with the given
+// ID. The user assignments are returned sorted by creation date, with the
+// most recently created user assignments appearing first.
+func (c *Client) GetProjectUserAssignments(projectID int, params GetUserAssignmentParameters) (UserAssignmentResponse, error) {
+	return c.GetProjectUserAssignmentsWithContext(context.Background(), projectID, params)
+}
+
+// GetProjectUserAssignments, bound to the provided context.
+func (c *Client) GetProjectUserAssignmentsWithContext(ctx context.Context, projectID int, params GetUserAssignmentParameters) (UserAssignmentResponse, error) {
+	return c.getUserAssignments(ctx, fmt.Sprintf("/v2/projects/%d/user_assignments", projectID), params)
+}
+
+// Returns an iterator over every user assignment for the project with the
+// given ID, requesting each page as it is needed. See Iterate.
+func (c *Client) IterProjectUserAssignments(ctx context.Context, projectID int, params GetUserAssignmentParameters) iter.Seq2[UserAssignment, error] {
+	urlTail, err := buildPathWithParams[GetUserAssignmentParameters](fmt.Sprintf("/v2/projects/%d/user_assignments", projectID), params)
+	if err != nil {
+		return errorSeq[UserAssignment](err)
+	}
+	return Iterate[UserAssignment, UserAssignmentResponse](ctx, c, urlTail)
+}
+
+func (c *Client) getUserAssignments(ctx context.Context, path string, params GetUserAssignmentParameters) (UserAssignmentResponse, error) {
+	ur := UserAssignmentResponse{}
+	urlTail, err := buildPathWithParams[GetUserAssignmentParameters](path, params)
+	if err != nil {
+		return ur, err
+	}
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ur, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ur)
+	if err != nil {
+		return ur, err
+	}
+	return ur, nil
+}
+
+// Retrieves the user assignment with the given ID on the given project.
+// Returns a user assignment object and a 200 OK response code if a valid
+// identifier was provided.
+func (c *Client) GetUserAssignment(projectID int, id int) (UserAssignment, error) {
+	return c.GetUserAssignmentWithContext(context.Background(), projectID, id)
+}
+
+// GetUserAssignment, bound to the provided context.
+func (c *Client) GetUserAssignmentWithContext(ctx context.Context, projectID int, id int) (UserAssignment, error) {
+	ua := UserAssignment{}
+	urlTail := fmt.Sprintf("/v2/projects/%d/user_assignments/%d", projectID, id)
+	res, err := c.GetWithContext(ctx, urlTail)
+	if err != nil {
+		return ua, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ua)
+	if err != nil {
+		return ua, err
+	}
+	return ua, nil
+}
+
+type CreateUserAssignmentBody struct {
+	// The ID of the user to associate with the project. - required
+	UserID int `json:"user_id" url:"user_id,omitempty"`
+
+	// Whether the user assignment is active or archived. Defaults to
+	// true. - optional
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Determines if the user has Project Manager permissions for the
+	// project. Defaults to false for users with Regular User permissions
+	// and true for those with Project Managers or Administrator
+	// permissions. - optional
+	IsProjectManager *bool `json:"is_project_manager,omitempty" url:"is_project_manager,omitempty"`
+
+	// Determines which billable rate(s) will be used on the project for
+	// this user when bill_by is People. When true, the project will use
+	// the user’s default billable rates. When false, the project will use
+	// the custom rate defined on this user assignment. Defaults to
+	// true. - optional
+	UseDefaultRates *bool `json:"use_default_rates,omitempty" url:"use_default_rates,omitempty"`
+
+	// Custom rate used when the project’s bill_by is People and
+	// use_default_rates is false. Defaults to 0. - optional
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// Budget used when the project’s budget_by is person. - optional
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+}
+
+func (b CreateUserAssignmentBody) IsValid() bool {
+	return b.UserID != 0
+}
+
+// Creates a new user assignment object on the given project. Returns a
+// user assignment object and a 201 Created response code if the call
+// succeeded.
+func (c *Client) CreateUserAssignment(projectID int, body CreateUserAssignmentBody) (UserAssignment, error) {
+	return c.CreateUserAssignmentWithContext(context.Background(), projectID, body)
+}
+
+// CreateUserAssignment, bound to the provided context.
+func (c *Client) CreateUserAssignmentWithContext(ctx context.Context, projectID int, body CreateUserAssignmentBody) (UserAssignment, error) {
+	ua := UserAssignment{}
+	if !body.IsValid() {
+		return ua, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/projects/%d/user_assignments", projectID)
+	res, err := c.PostWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ua, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ua)
+	if err != nil {
+		return ua, err
+	}
+	return ua, nil
+}
+
+type UpdateUserAssignmentBody struct {
+	// Whether the user assignment is active or archived.
+	IsActive *bool `json:"is_active,omitempty" url:"is_active,omitempty"`
+
+	// Determines if the user has Project Manager permissions for
+	// the project.
+	IsProjectManager *bool `json:"is_project_manager,omitempty" url:"is_project_manager,omitempty"`
+
+	// Determines which billable rate(s) will be used on the project for
+	// this user when bill_by is People.
+	UseDefaultRates *bool `json:"use_default_rates,omitempty" url:"use_default_rates,omitempty"`
+
+	// Custom rate used when the project’s bill_by is People and
+	// use_default_rates is false.
+	HourlyRate *float64 `json:"hourly_rate,omitempty" url:"hourly_rate,omitempty"`
+
+	// Budget used when the project’s budget_by is person.
+	Budget *float64 `json:"budget,omitempty" url:"budget,omitempty"`
+}
+
+func (b UpdateUserAssignmentBody) IsValid() bool {
+	return true
+}
+
+// Updates the specific user assignment by setting the values of the
+// parameters passed. Any parameters not provided will be left unchanged.
+// Returns a user assignment object and a 200 OK response code if the call
+// succeeded.
+func (c *Client) UpdateUserAssignment(projectID int, id int, body UpdateUserAssignmentBody) (UserAssignment, error) {
+	return c.UpdateUserAssignmentWithContext(context.Background(), projectID, id, body)
+}
+
+// UpdateUserAssignment, bound to the provided context.
+func (c *Client) UpdateUserAssignmentWithContext(ctx context.Context, projectID int, id int, body UpdateUserAssignmentBody) (UserAssignment, error) {
+	ua := UserAssignment{}
+	if !body.IsValid() {
+		return ua, errors.New("Invalid body")
+	}
+	urlTail := fmt.Sprintf("/v2/projects/%d/user_assignments/%d", projectID, id)
+	res, err := c.PatchWithContext(ctx, urlTail, body)
+	if err != nil {
+		return ua, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&ua)
+	if err != nil {
+		return ua, err
+	}
+	return ua, nil
+}
+
+// Delete a user assignment. Deleting a user assignment is only possible if
+// it has no time entries or expenses associated with it. Returns a 200 OK
+// response code if the call succeeded.
+func (c *Client) DeleteUserAssignment(projectID int, id int) error {
+	return c.DeleteUserAssignmentWithContext(context.Background(), projectID, id)
+}
+
+// DeleteUserAssignment, bound to the provided context.
+func (c *Client) DeleteUserAssignmentWithContext(ctx context.Context, projectID int, id int) error {
+	urlTail := fmt.Sprintf("/v2/projects/%d/user_assignments/%d", projectID, id)
+	return c.DeleteWithContext(ctx, urlTail)
+}